@@ -0,0 +1,173 @@
+package validrator
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/dot"
+	"github.com/thumbrise/validrator/internal/handlers"
+	strings_thumbrise "github.com/thumbrise/validrator/internal/strings"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Coercion is single conversion of input value to type of output field made in coercion mode, see WithCoercion.
+type Coercion struct {
+	// Field is dot notation key of field as declared in structure.
+	Field string
+	// Path is location of value in validated document with original keys.
+	Path validation.Path
+	// From is value as it was sent.
+	From interface{}
+	// To is value which was validated and decoded.
+	To interface{}
+	// Redacted reports whether From and To are replaced with validation.RedactedValue, see WithRedaction.
+	Redacted bool
+}
+
+// coerce converts values of decoded json to types of output fields and returns made conversions sorted by key.
+// Decoded json is not modified, so conversions keep sent values.
+func (s *schema) coerce(jsonMap map[string]interface{}) (map[string]interface{}, []Coercion) {
+	return s.convert(jsonMap, coerceScalar)
+}
+
+// convert returns copy of decoded json with values converted by convertScalar according to types of output fields
+// and made conversions sorted by key.
+func (s *schema) convert(jsonMap map[string]interface{}, convertScalar scalarConverter) (map[string]interface{}, []Coercion) {
+	coercions := make([]Coercion, 0)
+	result := make(map[string]interface{}, len(jsonMap))
+
+	for _, key := range slices.Sorted(maps.Keys(jsonMap)) {
+		result[key] = s.convertValue(jsonMap[key], []interface{}{key}, []string{patternKey(key)}, convertScalar, &coercions)
+	}
+
+	return result, coercions
+}
+
+// scalarConverter converts value to type of field and reports whether conversion was made.
+type scalarConverter func(value interface{}, typ reflect.Type) (interface{}, bool)
+
+// convertValue converts value to type of field, pattern is key of field with wildcard instead of array indexes.
+func (s *schema) convertValue(value interface{}, segments []interface{}, pattern []string, convertScalar scalarConverter, coercions *[]Coercion) interface{} {
+	typeKey := strings.Join(pattern, dot.Separator)
+
+	typ, ok := s.types[typeKey]
+	if !ok || value == nil {
+		return value
+	}
+
+	coerced, converted := convertScalar(value, typ)
+	if converted {
+		*coercions = append(*coercions, Coercion{
+			Field: validation.FieldKey(segments, s.maps()),
+			Path:  validation.NewPath(segments...),
+			From:  value,
+			To:    coerced,
+		})
+	}
+
+	switch casted := coerced.(type) {
+	case map[string]interface{}:
+		if typ.Kind() != reflect.Struct && typ.Kind() != reflect.Map {
+			return coerced
+		}
+
+		result := make(map[string]interface{}, len(casted))
+
+		for _, key := range slices.Sorted(maps.Keys(casted)) {
+			// Keys of map are data, so all values have type of its element
+			childPattern := patternKey(key)
+			if typ.Kind() == reflect.Map {
+				childPattern = "*"
+			}
+
+			result[key] = s.convertValue(casted[key], append(slices.Clone(segments), key), append(slices.Clone(pattern), childPattern), convertScalar, coercions)
+		}
+
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(casted))
+
+		for index, item := range casted {
+			result[index] = s.convertValue(item, append(slices.Clone(segments), index), append(slices.Clone(pattern), "*"), convertScalar, coercions)
+		}
+
+		return result
+	}
+
+	return coerced
+}
+
+// redactCoercions masks values of sensitive fields in coercions, like values of validation fails.
+func redactCoercions(validatable *validation.Validatable, coercions []Coercion) []Coercion {
+	for i, coercion := range coercions {
+		coercions[i].From, coercions[i].Redacted = validation.Redact(validatable, coercion.Field, coercion.From)
+		coercions[i].To, _ = validation.Redact(validatable, coercion.Field, coercion.To)
+	}
+
+	return coercions
+}
+
+// patternKey returns object key in form of declared keys.
+func patternKey(key string) string {
+	return dot.Escape(strings_thumbrise.ToCamel(key))
+}
+
+// coerceScalar converts strings to numbers and bools, numbers to strings and single values to one element slices.
+// Value which can not be converted is returned as is, so decoding reports it.
+func coerceScalar(value interface{}, typ reflect.Type) (interface{}, bool) {
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		if text, ok := value.(string); ok && isNumberText(text) {
+			return json.Number(text), true
+		}
+	case reflect.Bool:
+		if text, ok := value.(string); ok {
+			if parsed, err := strconv.ParseBool(text); err == nil {
+				return parsed, true
+			}
+		}
+	case reflect.String:
+		if number, ok := value.(json.Number); ok {
+			return number.String(), true
+		}
+	case reflect.Slice:
+		// []byte is decoded from base64 string
+		if _, ok := value.([]interface{}); !ok && typ.Elem().Kind() != reflect.Uint8 {
+			return []interface{}{value}, true
+		}
+	}
+
+	return value, false
+}
+
+// isNumberText reports whether text is json number without surrounding spaces.
+func isNumberText(text string) bool {
+	if text == "" || strings.TrimSpace(text) != text || (text[0] != '-' && (text[0] < '0' || text[0] > '9')) {
+		return false
+	}
+
+	return json.Valid([]byte(text))
+}
+
+// durationScalar converts Go or ISO 8601 duration string to nanoseconds for time.Duration field, which is decoded from number.
+func durationScalar(value interface{}, typ reflect.Type) (interface{}, bool) {
+	text, ok := value.(string)
+	if !ok || typ != durationType {
+		return value, false
+	}
+
+	duration, ok := handlers.ParseDuration(text)
+	if !ok {
+		return value, false
+	}
+
+	return json.Number(strconv.FormatInt(int64(duration), 10)), true
+}
@@ -0,0 +1,165 @@
+package validrator_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestValidrator_ValidateCoercion(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		Count int `validate:"min:1"`
+	}
+
+	type testStruct struct {
+		Age    int     `validate:"min:18"`
+		Price  float64 `validate:"decimal_max:10"`
+		Active bool    `validate:"boolean"`
+		Code   string  `validate:"len:3"`
+		Tags   []string
+		Items  []item
+		Raw    []byte
+		Name   string
+	}
+
+	inputJSON := `{
+		"age": "42",
+		"price": "9.99",
+		"active": "true",
+		"code": 123,
+		"tags": "new",
+		"items": {"count": "2"},
+		"raw": "aGk=",
+		"name": "john"
+	}`
+
+	validator := validrator.NewValidrator(validrator.WithCoercion())
+
+	output := &testStruct{}
+
+	report, err := validator.ValidateReport([]byte(inputJSON), output)
+	if err != nil {
+		t.Fatalf("ValidateReport() unexpected error = %v", err)
+	}
+
+	if report.Errors != nil {
+		t.Fatalf("ValidateReport() unexpected validation errors = %v", report.Errors)
+	}
+
+	expectedOutput := &testStruct{
+		Age:    42,
+		Price:  9.99,
+		Active: true,
+		Code:   "123",
+		Tags:   []string{"new"},
+		Items:  []item{{Count: 2}},
+		Raw:    []byte("hi"),
+		Name:   "john",
+	}
+	if diff := cmp.Diff(expectedOutput, output); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+
+	type coercion struct {
+		Pointer string
+		From    interface{}
+		To      interface{}
+	}
+
+	expected := []coercion{
+		{Pointer: "/active", From: "true", To: true},
+		{Pointer: "/age", From: "42", To: json.Number("42")},
+		{Pointer: "/code", From: json.Number("123"), To: "123"},
+		{Pointer: "/items", From: map[string]interface{}{"count": "2"}, To: []interface{}{map[string]interface{}{"count": "2"}}},
+		{Pointer: "/items/0/count", From: "2", To: json.Number("2")},
+		{Pointer: "/price", From: "9.99", To: json.Number("9.99")},
+		{Pointer: "/tags", From: "new", To: []interface{}{"new"}},
+	}
+
+	actual := make([]coercion, 0, len(report.Coercions))
+	for _, c := range report.Coercions {
+		actual = append(actual, coercion{Pointer: c.Path.JSONPointer(), From: c.From, To: c.To})
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("coercions mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateWithoutCoercion(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Age int `validate:"min:18"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	// String length is checked without coercion, then decoding fails
+	_, err := validator.Validate([]byte(`{"age": "123456789012345678"}`), &testStruct{})
+	if err == nil {
+		t.Fatal("Validate() error is missing, but wanted")
+	}
+}
+
+func TestValidrator_ValidateCoercionRedaction(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		Pin int `validate:"sensitive"`
+	}
+
+	type testStruct struct {
+		Password string `validate:"min:4"`
+		Items    []item
+		Age      int
+	}
+
+	inputJSON := `{"password": 12345, "items": [{"pin": "1234"}], "age": "42"}`
+
+	validator := validrator.NewValidrator(validrator.WithCoercion(), validrator.WithRedaction("password"))
+
+	output := &testStruct{}
+
+	report, err := validator.ValidateReport([]byte(inputJSON), output)
+	if err != nil {
+		t.Fatalf("ValidateReport() unexpected error = %v", err)
+	}
+
+	if report.Errors != nil {
+		t.Fatalf("ValidateReport() unexpected validation errors = %v", report.Errors)
+	}
+
+	// Decoded output keeps real values, only reported ones are masked
+	expectedOutput := &testStruct{Password: "12345", Items: []item{{Pin: 1234}}, Age: 42}
+	if diff := cmp.Diff(expectedOutput, output); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+
+	type coercion struct {
+		Pointer  string
+		From     interface{}
+		To       interface{}
+		Redacted bool
+	}
+
+	expected := []coercion{
+		{Pointer: "/age", From: "42", To: json.Number("42")},
+		{Pointer: "/items/0/pin", From: validation.RedactedValue, To: validation.RedactedValue, Redacted: true},
+		{Pointer: "/password", From: validation.RedactedValue, To: validation.RedactedValue, Redacted: true},
+	}
+
+	actual := make([]coercion, 0, len(report.Coercions))
+	for _, c := range report.Coercions {
+		actual = append(actual, coercion{Pointer: c.Path.JSONPointer(), From: c.From, To: c.To, Redacted: c.Redacted})
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("coercions mismatch (-want +got):\n%s", diff)
+	}
+}
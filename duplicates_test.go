@@ -0,0 +1,125 @@
+package validrator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestValidrator_ValidateDuplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Role  string `validate:"admin_only"`
+		Items []struct {
+			ID int
+		}
+	}
+
+	inputJSON := `{"role": "user", "items": [{"id": 1, "id": 2}], "role": "admin"}`
+
+	validator := validrator.NewValidrator(validrator.WithRejectDuplicateKeys())
+	validator.AddRuleHandler("admin_only", func(_ reflect.Value, _ []string) bool {
+		t.Error("rule must not run when duplicate keys found")
+
+		return true
+	})
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expected := []string{"/items/0/id", "/role"}
+
+	actual := make([]string, 0)
+	for _, fail := range validationErrors.Fields() {
+		actual = append(actual, fail.Path.JSONPointer())
+
+		if fail.Rules[0].Name != validation.RuleDuplicateKey {
+			t.Errorf("rule of %s = %q, want %q", fail.Field, fail.Rules[0].Name, validation.RuleDuplicateKey)
+		}
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("duplicates mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateDuplicateKeysFolded(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		FirstName string            `json:"first_name"`
+		Labels    map[string]string `json:"labels"`
+	}
+
+	inputJSON := `{"first_name": "john", "labels": {"Env": "a", "env": "b"}, "firstName": "jane"}`
+
+	validator := validrator.NewValidrator(validrator.WithRejectDuplicateKeys())
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	// Keys of map are data, so only keys decoded into the same struct field are duplicates
+	expected := []string{"/firstName"}
+
+	actual := make([]string, 0)
+	for _, fail := range validationErrors.Fields() {
+		actual = append(actual, fail.Path.JSONPointer())
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("duplicates mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateDuplicateMapKeys(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Counts map[string]int `json:"counts" validate:"[]min:1"`
+	}
+
+	inputJSON := `{"counts": {"my_key": 0, "my_key": 1, "other_key": 0}}`
+
+	validator := validrator.NewValidrator(validrator.WithRejectDuplicateKeys())
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	// Keys of map keep their spelling, like in fails of values
+	expected := map[string][]string{"counts.my_key": {validation.RuleDuplicateKey}}
+	if diff := cmp.Diff(expected, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+
+	validationErrors, err = validrator.NewValidrator().Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	expected = map[string][]string{"counts.other_key": {"min:1"}}
+	if diff := cmp.Diff(expected, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() without duplicates check mismatch (-want +got):\n%s", diff)
+	}
+}
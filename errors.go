@@ -0,0 +1,25 @@
+package validrator
+
+import (
+	"errors"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+var (
+	// ErrInvalidJSON is returned when input is not valid json.
+	ErrInvalidJSON = errors.New("invalid json")
+	// ErrLimitExceeded is returned when input exceeds Limits.
+	ErrLimitExceeded = errors.New("limit exceeded")
+	// ErrDecode is returned when valid json can not be decoded to output, for example because of type mismatch.
+	ErrDecode = errors.New("decode")
+	// ErrUnknownRule is returned when tag contains rule without registered handler.
+	ErrUnknownRule = validation.ErrUnknownRule
+	// ErrInvalidPattern is returned by RegisterPattern when pattern can not be compiled.
+	ErrInvalidPattern = errors.New("invalid pattern")
+	// ErrInvalidRuleArgs is returned when rule arguments can not be parsed by its handler.
+	ErrInvalidRuleArgs = validation.ErrInvalidRuleArgs
+	// ErrValidation matches validation errors returned by Check with errors.Is.
+	// Use errors.As with *validation.Error target to get details.
+	ErrValidation = validation.ErrValidation
+)
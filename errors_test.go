@@ -0,0 +1,105 @@
+package validrator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator"
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestValidrator_CheckErrors(t *testing.T) {
+	t.Parallel()
+
+	type testStructUnknownRule struct {
+		Field int `validate:"no_such_rule"`
+	}
+
+	type testStructInvalidArgs struct {
+		Field string `validate:"len:abc"`
+	}
+
+	type testStructDecode struct {
+		Field int `validate:"positive"`
+	}
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		output    any
+		wantErr   error
+	}{
+		{
+			name:      "invalid json",
+			inputJSON: `{"field": }`,
+			output:    &testStructDecode{},
+			wantErr:   validrator.ErrInvalidJSON,
+		},
+		{
+			name:      "unknown rule",
+			inputJSON: `{"field": 1}`,
+			output:    &testStructUnknownRule{},
+			wantErr:   validrator.ErrUnknownRule,
+		},
+		{
+			name:      "invalid rule arguments",
+			inputJSON: `{"field": "value"}`,
+			output:    &testStructInvalidArgs{},
+			wantErr:   validrator.ErrInvalidRuleArgs,
+		},
+		{
+			name:      "decode",
+			inputJSON: `{"field": 1.5}`,
+			output:    &testStructDecode{},
+			wantErr:   validrator.ErrDecode,
+		},
+		{
+			name:      "validation",
+			inputJSON: `{"field": -1}`,
+			output:    &testStructDecode{},
+			wantErr:   validrator.ErrValidation,
+		},
+		{
+			name:      "valid",
+			inputJSON: `{"field": 1}`,
+			output:    &testStructDecode{},
+			wantErr:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+			validator.AddRuleHandler("len", handlers.HasLengthOf)
+			validator.AddRuleHandler("positive", func(v reflect.Value, _ []string) bool {
+				number, ok := validrator.NumberOf(v)
+
+				return ok && number > 0
+			})
+
+			err := validator.Check([]byte(tt.inputJSON), tt.output)
+
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Check() unexpected error = %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var validationErrors *validation.Error
+
+			isValidation := errors.As(err, &validationErrors)
+			if isValidation != errors.Is(tt.wantErr, validrator.ErrValidation) {
+				t.Errorf("errors.As() = %v for error %v", isValidation, err)
+			}
+		})
+	}
+}
@@ -1,40 +1,131 @@
 // Package dot provides dot-notation functionality
 package dot
 
-import "strconv"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Separator joins segments of flat key.
+const Separator = "."
+
+//nolint:gochecknoglobals
+var (
+	escaper   = strings.NewReplacer("~", "~0", Separator, "~1")
+	unescaper = strings.NewReplacer("~1", Separator, "~0", "~")
+)
+
+var (
+	// ErrMaxDepth is returned when input is nested deeper than Limits.MaxDepth.
+	ErrMaxDepth = errors.New("max depth exceeded")
+	// ErrMaxArrayLength is returned when input contains array longer than Limits.MaxArrayLength.
+	ErrMaxArrayLength = errors.New("max array length exceeded")
+)
+
+// Limits bound flattening of untrusted input. Zero field means no limit.
+type Limits struct {
+	// MaxDepth is max count of segments in key, {"a":{"b":1}} has depth 2.
+	MaxDepth int
+	// MaxArrayLength is max count of elements in any array.
+	MaxArrayLength int
+}
 
 // Map converts nested map to flat dot notation projection of map. You want use this when input is result of json unmarshalling.
+// Object keys containing dots or tildes are escaped, see Escape.
 func Map(input map[string]interface{}) map[string]interface{} {
+	result, _, _ := Flatten(input, Limits{})
+
+	return result
+}
+
+// Escape returns object key as segment of flat key: "~" is written as "~0" and dot as "~1" like in JSON Pointer,
+// so {"a.b":1} and {"a":{"b":1}} have different flat keys "a~1b" and "a.b".
+func Escape(key string) string {
+	return escaper.Replace(key)
+}
+
+// Unescape returns object key of flat key segment written by Escape.
+func Unescape(segment string) string {
+	return unescaper.Replace(segment)
+}
+
+// Join returns flat key of segments, where segment is string for object key and int for array index.
+func Join(segments []interface{}) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		switch casted := segment.(type) {
+		case int:
+			parts = append(parts, strconv.Itoa(casted))
+		case string:
+			parts = append(parts, Escape(casted))
+		}
+	}
+
+	return strings.Join(parts, Separator)
+}
+
+// Flatten works like Map, but also returns segments of every flat key.
+// Segment is string for object key and int for array index. Object keys are escaped in flat keys, see Escape.
+// Flattening stops with error wrapping ErrMaxDepth or ErrMaxArrayLength when input exceeds limits.
+func Flatten(input map[string]interface{}, limits Limits) (map[string]interface{}, map[string][]interface{}, error) {
 	result := make(map[string]interface{})
+	segments := make(map[string][]interface{})
 
-	mapRecursive(input, result, "")
+	err := mapRecursive(input, result, segments, "", nil, limits)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return result
+	return result, segments, nil
 }
 
-func mapRecursive(input any, output map[string]interface{}, outputKey string) {
+func mapRecursive(input any, output map[string]interface{}, outputSegments map[string][]interface{}, outputKey string, segments []interface{}, limits Limits) error {
 	nextNodes := make(map[string]interface{})
+	nextSegments := make(map[string]interface{})
 	nextPrefix := ""
 
 	if outputKey != "" {
 		output[outputKey] = input
-		nextPrefix = outputKey + "."
+		outputSegments[outputKey] = segments
+		nextPrefix = outputKey + Separator
 	}
 
 	switch castedInput := input.(type) {
 	case map[string]interface{}:
 		for key, value := range castedInput {
-			nextNodes[key] = value
+			nextNodes[Escape(key)] = value
+			nextSegments[Escape(key)] = key
 		}
 	case []interface{}:
+		if limits.MaxArrayLength > 0 && len(castedInput) > limits.MaxArrayLength {
+			return fmt.Errorf("%w: %s has %d elements, limit is %d", ErrMaxArrayLength, outputKey, len(castedInput), limits.MaxArrayLength)
+		}
+
 		for key, value := range castedInput {
 			nextNodes[strconv.Itoa(key)] = value
+			nextSegments[strconv.Itoa(key)] = key
 		}
 	}
 
+	if len(nextNodes) > 0 && limits.MaxDepth > 0 && len(segments) >= limits.MaxDepth {
+		return fmt.Errorf("%w: %s is nested deeper than %d", ErrMaxDepth, outputKey, limits.MaxDepth)
+	}
+
 	for key, value := range nextNodes {
 		nextOutputKey := nextPrefix + key
 
-		mapRecursive(value, output, nextOutputKey)
+		nextOutputSegments := make([]interface{}, len(segments), len(segments)+1)
+		copy(nextOutputSegments, segments)
+		nextOutputSegments = append(nextOutputSegments, nextSegments[key])
+
+		err := mapRecursive(value, output, outputSegments, nextOutputKey, nextOutputSegments, limits)
+		if err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
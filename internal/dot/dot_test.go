@@ -1,6 +1,7 @@
 package dot_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/thumbrise/validrator/internal/dot"
@@ -92,3 +93,96 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"c.d": "dotted",
+		"c": map[string]interface{}{
+			"d":   "nested",
+			"e~f": "tilde",
+		},
+		"a": map[string]interface{}{
+			"b": "nested",
+		},
+		"items": []interface{}{
+			map[string]interface{}{"x/y": 1},
+		},
+	}
+
+	wantSegments := map[string][]interface{}{
+		"a":           {"a"},
+		"a.b":         {"a", "b"},
+		"c~1d":        {"c.d"},
+		"c":           {"c"},
+		"c.d":         {"c", "d"},
+		"c.e~0f":      {"c", "e~f"},
+		"items":       {"items"},
+		"items.0":     {"items", 0},
+		"items.0.x/y": {"items", 0, "x/y"},
+	}
+
+	_, gotSegments, err := dot.Flatten(input, dot.Limits{})
+	if err != nil {
+		t.Fatalf("Flatten() unexpected error = %v", err)
+	}
+
+	diff := testutil.DiffAsJSON(wantSegments, gotSegments)
+	if diff != "" {
+		t.Errorf(
+			"segments not match\nexpected:\n%#v\nactual:\n%#v\ndiff:\n%s\n",
+			wantSegments,
+			gotSegments,
+			diff,
+		)
+	}
+}
+
+func TestFlattenLimits(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []interface{}{1, 2, 3},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		limits  dot.Limits
+		wantErr error
+	}{
+		{
+			name:    "no limits",
+			limits:  dot.Limits{},
+			wantErr: nil,
+		},
+		{
+			name:    "limits are not exceeded",
+			limits:  dot.Limits{MaxDepth: 3, MaxArrayLength: 3},
+			wantErr: nil,
+		},
+		{
+			name:    "depth is exceeded",
+			limits:  dot.Limits{MaxDepth: 2},
+			wantErr: dot.ErrMaxDepth,
+		},
+		{
+			name:    "array length is exceeded",
+			limits:  dot.Limits{MaxArrayLength: 2},
+			wantErr: dot.ErrMaxArrayLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := dot.Flatten(input, tt.limits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Flatten() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ibanLengths are lengths of IBAN by country according to SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// cardBrand is issuer of payment card detected by prefix (IIN range) and length of number.
+type cardBrand struct {
+	name     string
+	prefixes [][2]int
+	lengths  []int
+}
+
+// cardBrands are checked in order, so narrow ranges go before wide ones.
+var cardBrands = []cardBrand{
+	{name: "amex", prefixes: [][2]int{{34, 34}, {37, 37}}, lengths: []int{15}},
+	{name: "visa", prefixes: [][2]int{{4, 4}}, lengths: []int{13, 16, 19}},
+	{name: "mastercard", prefixes: [][2]int{{51, 55}, {2221, 2720}}, lengths: []int{16}},
+	{name: "mir", prefixes: [][2]int{{2200, 2204}}, lengths: []int{16, 17, 18, 19}},
+	{name: "discover", prefixes: [][2]int{{6011, 6011}, {644, 649}, {65, 65}}, lengths: []int{16, 17, 18, 19}},
+	{name: "jcb", prefixes: [][2]int{{3528, 3589}}, lengths: []int{16, 17, 18, 19}},
+	{name: "diners", prefixes: [][2]int{{300, 305}, {36, 36}, {38, 39}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{name: "unionpay", prefixes: [][2]int{{62, 62}}, lengths: []int{16, 17, 18, 19}},
+}
+
+// IsLuhn is the validation function for validating if the current field's value is digits with valid Luhn check digit.
+func IsLuhn(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+
+	return ok && isDigits(digits) && isLuhnValid(digits)
+}
+
+// IsCreditCard is the validation function for validating if the current field's value is payment card number of known brand
+// with valid Luhn check digit. Spaces and hyphens are ignored. Params restrict brands, "credit_card:visa,mastercard".
+// Known brands are amex, visa, mastercard, mir, discover, jcb, diners and unionpay.
+func IsCreditCard(field reflect.Value, params []string) bool {
+	digits, ok := digitsOf(field)
+	if !ok {
+		return false
+	}
+
+	digits = strings.NewReplacer(" ", "", "-", "").Replace(digits)
+	if !isDigits(digits) || !isLuhnValid(digits) {
+		return false
+	}
+
+	brand := detectCardBrand(digits)
+	if brand == "" {
+		return false
+	}
+
+	return len(params) == 0 || slices.Contains(params, brand)
+}
+
+// IsIBAN is the validation function for validating if the current field's value is IBAN with length of its country
+// and valid check digits. Spaces are ignored, letters are case-insensitive.
+func IsIBAN(field reflect.Value, _ []string) bool {
+	if !isText(field) {
+		return false
+	}
+
+	iban := strings.ToUpper(strings.ReplaceAll(field.String(), " ", ""))
+	if len(iban) < 4 || ibanLengths[iban[:2]] != len(iban) {
+		return false
+	}
+
+	// Check digits are valid when rearranged IBAN with letters as numbers (A is 10) is 1 modulo 97
+	remainder := 0
+
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97 //nolint:mnd
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A') + 10) % 97 //nolint:mnd
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+// IsISBN10 is the validation function for validating if the current field's value is ISBN-10 with valid check digit, which may be X.
+// Spaces and hyphens are ignored.
+func IsISBN10(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+	if !ok {
+		return false
+	}
+
+	digits = strings.NewReplacer(" ", "", "-", "").Replace(digits)
+	if len(digits) != 10 || !isDigits(digits[:9]) { //nolint:mnd
+		return false
+	}
+
+	sum := 0
+	for i, r := range digits {
+		value := int(r - '0')
+
+		switch {
+		case i == 9 && (r == 'X' || r == 'x'):
+			value = 10
+		case r < '0' || r > '9':
+			return false
+		}
+
+		sum += (10 - i) * value
+	}
+
+	return sum%11 == 0
+}
+
+// IsISBN13 is the validation function for validating if the current field's value is ISBN-13 with valid check digit.
+// Spaces and hyphens are ignored.
+func IsISBN13(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+	if !ok {
+		return false
+	}
+
+	digits = strings.NewReplacer(" ", "", "-", "").Replace(digits)
+
+	return len(digits) == 13 && (strings.HasPrefix(digits, "978") || strings.HasPrefix(digits, "979")) && isGTINValid(digits)
+}
+
+// IsEAN8 is the validation function for validating if the current field's value is EAN-8 with valid check digit.
+func IsEAN8(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+
+	return ok && len(digits) == 8 && isGTINValid(digits)
+}
+
+// IsEAN13 is the validation function for validating if the current field's value is EAN-13 with valid check digit.
+func IsEAN13(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+
+	return ok && len(digits) == 13 && isGTINValid(digits)
+}
+
+// IsISSN is the validation function for validating if the current field's value is ISSN with valid check digit, which may be X.
+// Hyphen between halves is optional: "0378-5955" or "03785955".
+func IsISSN(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+	if !ok {
+		return false
+	}
+
+	if len(digits) == 9 && digits[4] == '-' { //nolint:mnd
+		digits = digits[:4] + digits[5:]
+	}
+
+	if len(digits) != 8 || !isDigits(digits[:7]) { //nolint:mnd
+		return false
+	}
+
+	sum := 0
+	for i, r := range digits[:7] {
+		sum += (8 - i) * int(r-'0')
+	}
+
+	check := (11 - sum%11) % 11
+
+	if check == 10 { //nolint:mnd
+		return digits[7] == 'X' || digits[7] == 'x'
+	}
+
+	return int(digits[7]-'0') == check
+}
+
+// IsIMEI is the validation function for validating if the current field's value is 15 digits IMEI with valid Luhn check digit.
+func IsIMEI(field reflect.Value, _ []string) bool {
+	digits, ok := digitsOf(field)
+
+	return ok && len(digits) == 15 && isDigits(digits) && isLuhnValid(digits)
+}
+
+// digitsOf returns text of string, json number or go integer. Notice that numbers lose leading zeros.
+func digitsOf(field reflect.Value) (string, bool) {
+	switch field.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return field.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	}
+
+	return "", false
+}
+
+func isDigits(text string) bool {
+	return text != "" && strings.Trim(text, "0123456789") == ""
+}
+
+func isLuhnValid(digits string) bool {
+	sum := 0
+
+	for i := range len(digits) {
+		digit := int(digits[len(digits)-1-i] - '0')
+
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 { //nolint:mnd
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+// isGTINValid checks check digit of EAN-8, EAN-13 and other GTIN: digits are weighted 3 and 1 alternately from the right.
+func isGTINValid(digits string) bool {
+	if !isDigits(digits) {
+		return false
+	}
+
+	sum := 0
+
+	for i := range len(digits) - 1 {
+		digit := int(digits[len(digits)-2-i] - '0')
+
+		if i%2 == 0 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return (10-sum%10)%10 == int(digits[len(digits)-1]-'0')
+}
+
+func detectCardBrand(digits string) string {
+	for _, brand := range cardBrands {
+		if !slices.Contains(brand.lengths, len(digits)) {
+			continue
+		}
+
+		for _, prefix := range brand.prefixes {
+			width := len(strconv.Itoa(prefix[0]))
+
+			value, err := strconv.Atoi(digits[:width])
+			if err == nil && value >= prefix[0] && value <= prefix[1] {
+				return brand.name
+			}
+		}
+	}
+
+	return ""
+}
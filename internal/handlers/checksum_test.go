@@ -0,0 +1,73 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+func TestChecksumHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule   string
+		value  interface{}
+		params []string
+		want   bool
+	}{
+		{rule: "luhn", value: "79927398713", want: true},
+		{rule: "luhn", value: json.Number("79927398713"), want: true},
+		{rule: "luhn", value: 79927398713, want: true},
+		{rule: "luhn", value: "79927398710", want: false},
+		{rule: "luhn", value: "7992739871a", want: false},
+		{rule: "credit_card", value: "4111 1111 1111 1111", want: true},
+		{rule: "credit_card", value: json.Number("4111111111111111"), want: true},
+		{rule: "credit_card", value: "3782-822463-10005", params: []string{"amex"}, want: true},
+		{rule: "credit_card", value: "2221000000000009", params: []string{"mastercard"}, want: true},
+		{rule: "credit_card", value: "5555555555554444", params: []string{"visa"}, want: false},
+		{rule: "credit_card", value: "2200000000000004", params: []string{"mir"}, want: true},
+		{rule: "credit_card", value: "6011111111111117", params: []string{"discover"}, want: true},
+		{rule: "credit_card", value: "3530111333300000", params: []string{"jcb"}, want: true},
+		{rule: "credit_card", value: "4111111111111112", want: false},
+		{rule: "credit_card", value: "79927398713", want: false},
+		{rule: "iban", value: "GB82 WEST 1234 5698 7654 32", want: true},
+		{rule: "iban", value: "de89370400440532013000", want: true},
+		{rule: "iban", value: "GB82WEST12345698765433", want: false},
+		{rule: "iban", value: json.Number("1234"), want: false},
+		{rule: "iban", value: "DE8937040044053201300", want: false},
+		{rule: "iban", value: "XX82WEST12345698765432", want: false},
+		{rule: "isbn10", value: "0-306-40615-2", want: true},
+		{rule: "isbn10", value: "080442957X", want: true},
+		{rule: "isbn10", value: "0306406153", want: false},
+		{rule: "isbn10", value: "X306406152", want: false},
+		{rule: "isbn13", value: "978-0-306-40615-7", want: true},
+		{rule: "isbn13", value: json.Number("9780306406157"), want: true},
+		{rule: "isbn13", value: "9780306406158", want: false},
+		{rule: "isbn13", value: "4006381333931", want: false},
+		{rule: "ean8", value: "96385074", want: true},
+		{rule: "ean8", value: "96385075", want: false},
+		{rule: "ean13", value: "4006381333931", want: true},
+		{rule: "ean13", value: 4006381333931, want: true},
+		{rule: "ean13", value: "4006381333932", want: false},
+		{rule: "issn", value: "0378-5955", want: true},
+		{rule: "issn", value: "2434-561X", want: true},
+		{rule: "issn", value: json.Number("3178471"), want: false},
+		{rule: "issn", value: "03178471", want: true},
+		{rule: "issn", value: "0378-5956", want: false},
+		{rule: "imei", value: "490154203237518", want: true},
+		{rule: "imei", value: json.Number("490154203237518"), want: true},
+		{rule: "imei", value: "490154203237519", want: false},
+		{rule: "imei", value: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", tt.rule, tt.value, tt.params, got, tt.want)
+			}
+		})
+	}
+}
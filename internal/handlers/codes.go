@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	_ "embed"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // timezone rule must work on systems without zoneinfo
+)
+
+//go:generate go run data/generate.go
+
+// Code tables are generated from JSON files of Debian iso-codes project. To refresh them install recent "iso-codes" package
+// (or clone https://salsa.debian.org/iso-codes-team/iso-codes) and run "go generate" in this directory,
+// other location of JSON files is passed by "go run data/generate.go <directory>". Review diff of data directory before commit.
+// Timezones come from time/tzdata of Go toolchain and are refreshed with Go version.
+var (
+	//go:embed data/iso3166.tsv
+	iso3166Table string
+	//go:embed data/iso4217.txt
+	iso4217Table string
+	//go:embed data/iso639_1.txt
+	iso639Table string
+)
+
+// codeSets are sets of codes parsed from tables on first use.
+type codeSets struct {
+	alpha2   map[string]bool
+	alpha3   map[string]bool
+	numeric  map[string]bool
+	currency map[string]bool
+	language map[string]bool
+}
+
+var codes = sync.OnceValue(func() *codeSets {
+	sets := &codeSets{
+		alpha2:   make(map[string]bool),
+		alpha3:   make(map[string]bool),
+		numeric:  make(map[string]bool),
+		currency: lineSet(iso4217Table),
+		language: lineSet(iso639Table),
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(iso3166Table), "\n") {
+		columns := strings.Split(line, "\t")
+		sets.alpha2[columns[0]] = true
+		sets.alpha3[columns[1]] = true
+		sets.numeric[columns[2]] = true
+	}
+
+	return sets
+})
+
+// IsISO3166Alpha2 is the validation function for validating if the current field's value is ISO 3166-1 alpha-2 country code ("DE").
+func IsISO3166Alpha2(field reflect.Value, _ []string) bool {
+	return isText(field) && codes().alpha2[field.String()]
+}
+
+// IsISO3166Alpha3 is the validation function for validating if the current field's value is ISO 3166-1 alpha-3 country code ("DEU").
+func IsISO3166Alpha3(field reflect.Value, _ []string) bool {
+	return isText(field) && codes().alpha3[field.String()]
+}
+
+// IsISO3166Numeric is the validation function for validating if the current field's value is ISO 3166-1 numeric country code.
+// String must have 3 digits ("004"), number may omit leading zeros (4).
+func IsISO3166Numeric(field reflect.Value, _ []string) bool {
+	text, ok := digitsOf(field)
+	if !ok {
+		return false
+	}
+
+	if !isText(field) {
+		text = fmt.Sprintf("%03s", text)
+	}
+
+	return codes().numeric[text]
+}
+
+// IsISO4217 is the validation function for validating if the current field's value is ISO 4217 currency code ("EUR").
+func IsISO4217(field reflect.Value, _ []string) bool {
+	return isText(field) && codes().currency[field.String()]
+}
+
+// IsISO639_1 is the validation function for validating if the current field's value is ISO 639-1 language code ("en").
+func IsISO639_1(field reflect.Value, _ []string) bool { //nolint:revive,stylecheck
+	return isText(field) && codes().language[field.String()]
+}
+
+// IsBCP47 is the validation function for validating if the current field's value is well-formed BCP 47 language tag ("en-US", "zh-Hant-TW").
+// Two letters language subtag must be ISO 639-1 code, other subtags are checked by syntax only. Subtags are case-insensitive.
+func IsBCP47(field reflect.Value, _ []string) bool {
+	if !isText(field) {
+		return false
+	}
+
+	return isLanguageTag(strings.Split(strings.ToLower(field.String()), "-"))
+}
+
+// IsTimezone is the validation function for validating if the current field's value is IANA time zone name ("Europe/Berlin", "UTC").
+func IsTimezone(field reflect.Value, _ []string) bool {
+	if !isText(field) {
+		return false
+	}
+
+	name := field.String()
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+
+	return err == nil
+}
+
+// isLanguageTag checks syntax of RFC 5646: language [-script] [-region] *(-variant) *(-extension) [-privateuse].
+func isLanguageTag(subtags []string) bool { //nolint:cyclop
+	if subtags[0] == "x" {
+		return isPrivateUse(subtags)
+	}
+
+	language := subtags[0]
+
+	switch {
+	case len(language) == 2 && isAlpha(language): //nolint:mnd
+		if !codes().language[language] {
+			return false
+		}
+	case len(language) >= 3 && len(language) <= 8 && isAlpha(language): //nolint:mnd
+	default:
+		return false
+	}
+
+	rest := subtags[1:]
+
+	if len(rest) > 0 && len(rest[0]) == 4 && isAlpha(rest[0]) { // script
+		rest = rest[1:]
+	}
+
+	if len(rest) > 0 && ((len(rest[0]) == 2 && isAlpha(rest[0])) || (len(rest[0]) == 3 && isDigits(rest[0]))) { // region
+		rest = rest[1:]
+	}
+
+	for len(rest) > 0 && isVariant(rest[0]) {
+		rest = rest[1:]
+	}
+
+	for len(rest) > 0 && len(rest[0]) == 1 && rest[0] != "x" { // extension
+		singleton := rest[0]
+		rest = rest[1:]
+
+		count := 0
+		for len(rest) > 0 && len(rest[0]) >= 2 && len(rest[0]) <= 8 && isAlphanumeric(rest[0]) {
+			rest = rest[1:]
+			count++
+		}
+
+		if count == 0 || !isAlphanumeric(singleton) {
+			return false
+		}
+	}
+
+	if len(rest) > 0 {
+		return isPrivateUse(rest)
+	}
+
+	return true
+}
+
+func isPrivateUse(subtags []string) bool {
+	if subtags[0] != "x" || len(subtags) < 2 { //nolint:mnd
+		return false
+	}
+
+	for _, subtag := range subtags[1:] {
+		if subtag == "" || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isVariant(subtag string) bool {
+	if !isAlphanumeric(subtag) {
+		return false
+	}
+
+	return (len(subtag) >= 5 && len(subtag) <= 8) || (len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9')
+}
+
+func isAlpha(text string) bool {
+	return text != "" && strings.Trim(text, "abcdefghijklmnopqrstuvwxyz") == ""
+}
+
+func isAlphanumeric(text string) bool {
+	return text != "" && strings.Trim(text, "abcdefghijklmnopqrstuvwxyz0123456789") == ""
+}
+
+func lineSet(table string) map[string]bool {
+	result := make(map[string]bool)
+
+	for _, line := range strings.Fields(table) {
+		result[line] = true
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+func TestCodeHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule  string
+		value interface{}
+		want  bool
+	}{
+		{rule: "iso3166_alpha2", value: "DE", want: true},
+		{rule: "iso3166_alpha2", value: "de", want: false},
+		{rule: "iso3166_alpha2", value: "XX", want: false},
+		{rule: "iso3166_alpha2", value: json.Number("12"), want: false},
+		{rule: "iso3166_alpha3", value: "DEU", want: true},
+		{rule: "iso3166_alpha3", value: "DE", want: false},
+		{rule: "iso3166_numeric", value: "004", want: true},
+		{rule: "iso3166_numeric", value: json.Number("4"), want: true},
+		{rule: "iso3166_numeric", value: 276, want: true},
+		{rule: "iso3166_numeric", value: "4", want: false},
+		{rule: "iso3166_numeric", value: json.Number("999"), want: false},
+		{rule: "iso4217", value: "EUR", want: true},
+		{rule: "iso4217", value: "eur", want: false},
+		{rule: "iso4217", value: "ABC", want: false},
+		{rule: "iso639_1", value: "en", want: true},
+		{rule: "iso639_1", value: "eng", want: false},
+		{rule: "iso639_1", value: json.Number("1"), want: false},
+		{rule: "iso639_1", value: "qq", want: false},
+		{rule: "bcp47", value: "en", want: true},
+		{rule: "bcp47", value: "en-US", want: true},
+		{rule: "bcp47", value: "zh-Hant-TW", want: true},
+		{rule: "bcp47", value: "es-419", want: true},
+		{rule: "bcp47", value: "cmn-Hans-CN", want: true},
+		{rule: "bcp47", value: "de-CH-1996", want: true},
+		{rule: "bcp47", value: "en-US-u-ca-gregory-x-private", want: true},
+		{rule: "bcp47", value: "x-whatever", want: true},
+		{rule: "bcp47", value: "qq-US", want: false},
+		{rule: "bcp47", value: "en_US", want: false},
+		{rule: "bcp47", value: "en-US-u", want: false},
+		{rule: "bcp47", value: "en-", want: false},
+		{rule: "bcp47", value: "", want: false},
+		{rule: "timezone", value: "Europe/Berlin", want: true},
+		{rule: "timezone", value: "UTC", want: true},
+		{rule: "timezone", value: "Local", want: false},
+		{rule: "timezone", value: "", want: false},
+		{rule: "timezone", value: "Mars/Olympus", want: false},
+		{rule: "timezone", value: "../../etc/passwd", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), nil); got != tt.want {
+				t.Errorf("%s(%v) = %v, want %v", tt.rule, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	strings_thumbrise "github.com/thumbrise/validrator/internal/strings"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// HasMinItems is the validation function for validating if the current field's value is array with at least param items.
+func HasMinItems(field reflect.Value, params []string) bool {
+	limit := asCount(params)
+
+	return isList(field) && int64(field.Len()) >= limit
+}
+
+// HasMaxItems is the validation function for validating if the current field's value is array with at most param items.
+func HasMaxItems(field reflect.Value, params []string) bool {
+	limit := asCount(params)
+
+	return isList(field) && int64(field.Len()) <= limit
+}
+
+// IsUnique is the validation function for validating if the current field's value is array without equal items.
+// Numbers are equal by value, so 1 and 1.0 are duplicates. Param is key of object items compared instead of whole items,
+// "unique:id"; items without the key are not compared.
+func IsUnique(field reflect.Value, params []string) bool {
+	if !isList(field) {
+		return false
+	}
+
+	seen := make(map[string]bool, field.Len())
+
+	for i := range field.Len() {
+		item := field.Index(i)
+
+		if len(params) > 0 {
+			var ok bool
+
+			item, ok = objectValue(item, params[0])
+			if !ok {
+				continue
+			}
+		}
+
+		key := itemKey(item)
+		if seen[key] {
+			return false
+		}
+
+		seen[key] = true
+	}
+
+	return true
+}
+
+// ContainsValue is the validation function for validating if the current field's value is array with item equal to param,
+// "contains_value:admin". Numbers are equal by value.
+func ContainsValue(field reflect.Value, params []string) bool {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: contains_value expects 1 argument", validation.ErrInvalidRuleArgs))
+	}
+
+	if !isList(field) {
+		return false
+	}
+
+	for i := range field.Len() {
+		if matchesParam(field.Index(i), params[0]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSubsetOf is the validation function for validating if the current field's value is array which items are all equal to any of params,
+// "subset_of:read,write,admin".
+func IsSubsetOf(field reflect.Value, params []string) bool {
+	if !isList(field) {
+		return false
+	}
+
+	for i := range field.Len() {
+		matched := false
+
+		for _, param := range params {
+			if matchesParam(field.Index(i), param) {
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSorted is the validation function for validating if the current field's value is array of numbers or strings in ascending order.
+// Equal neighbours are allowed, strings are compared by bytes.
+func IsSorted(field reflect.Value, _ []string) bool {
+	return isSortedBy(field, func(result int) bool { return result <= 0 })
+}
+
+// IsSortedDesc is the validation function for validating if the current field's value is array of numbers or strings in descending order.
+func IsSortedDesc(field reflect.Value, _ []string) bool {
+	return isSortedBy(field, func(result int) bool { return result >= 0 })
+}
+
+func isSortedBy(field reflect.Value, inOrder func(result int) bool) bool {
+	if !isList(field) {
+		return false
+	}
+
+	for i := 1; i < field.Len(); i++ {
+		result, ok := compareItems(field.Index(i-1), field.Index(i))
+		if !ok || !inOrder(result) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareItems compares two numbers or two strings. Items of other or different types are not comparable.
+func compareItems(a, b reflect.Value) (int, bool) {
+	numberA, okA := ratOf(a)
+	numberB, okB := ratOf(b)
+
+	if okA && okB {
+		return numberA.Cmp(numberB), true
+	}
+
+	a, b = unwrapInterface(a), unwrapInterface(b)
+	if okA || okB || a.Kind() != reflect.String || b.Kind() != reflect.String {
+		return 0, false
+	}
+
+	return cmp.Compare(a.String(), b.String()), true
+}
+
+// matchesParam reports whether item is string, number or bool equal to param.
+func matchesParam(item reflect.Value, param string) bool {
+	if number, ok := ratOf(item); ok {
+		expected, ok := parseNumber(param)
+
+		return ok && number.Cmp(expected) == 0
+	}
+
+	item = unwrapInterface(item)
+
+	switch item.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return item.String() == param
+	case reflect.Bool:
+		return strconv.FormatBool(item.Bool()) == param
+	}
+
+	return false
+}
+
+// ratOf returns json number or go number as exact rational number.
+func ratOf(item reflect.Value) (*big.Rat, bool) {
+	item = unwrapInterface(item)
+	if !item.IsValid() {
+		return nil, false
+	}
+
+	if isJSONNumber(item) {
+		return parseNumber(item.String())
+	}
+
+	switch item.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(item.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetUint64(item.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		// Rat of NaN or infinity is nil
+		number := new(big.Rat).SetFloat64(item.Float())
+
+		return number, number != nil
+	}
+
+	return nil, false
+}
+
+// itemKey returns text which is equal for equal items. Numbers are keyed by exact value, other items by type and formatted value,
+// fmt sorts keys of maps, so equal objects have equal keys.
+func itemKey(item reflect.Value) string {
+	if number, ok := ratOf(item); ok {
+		return "number:" + number.RatString()
+	}
+
+	item = unwrapInterface(item)
+	if !item.IsValid() {
+		return "null"
+	}
+
+	return fmt.Sprintf("%T:%v", item.Interface(), item.Interface())
+}
+
+// objectValue returns value of key in object item. Key is matched in camel case, so "user_id" matches "userId" key.
+func objectValue(item reflect.Value, key string) (reflect.Value, bool) {
+	item = unwrapInterface(item)
+	if item.Kind() != reflect.Map || item.Type().Key().Kind() != reflect.String {
+		return reflect.Value{}, false
+	}
+
+	camelKey := strings_thumbrise.ToCamel(key)
+
+	iterator := item.MapRange()
+	for iterator.Next() {
+		if strings_thumbrise.ToCamel(iterator.Key().String()) == camelKey {
+			return iterator.Value(), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func unwrapInterface(item reflect.Value) reflect.Value {
+	for item.Kind() == reflect.Interface {
+		item = item.Elem()
+	}
+
+	return item
+}
+
+func isList(field reflect.Value) bool {
+	return field.Kind() == reflect.Slice || field.Kind() == reflect.Array
+}
+
+// asCount returns the first parameter as count of items
+// or panics if it can't convert.
+func asCount(params []string) int64 {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: items rule expects 1 argument", validation.ErrInvalidRuleArgs))
+	}
+
+	count, err := strconv.ParseInt(params[0], 10, 64)
+	if err != nil || count < 0 {
+		panic(fmt.Errorf("%w: %q is not count of items", validation.ErrInvalidRuleArgs, params[0]))
+	}
+
+	return count
+}
@@ -0,0 +1,66 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+func TestCollectionHandlers(t *testing.T) {
+	t.Parallel()
+
+	objects := []interface{}{
+		map[string]interface{}{"user_id": json.Number("1"), "name": "a"},
+		map[string]interface{}{"user_id": json.Number("2"), "name": "a"},
+		map[string]interface{}{"name": "b"},
+	}
+
+	tests := []struct {
+		rule   string
+		params []string
+		value  interface{}
+		want   bool
+	}{
+		{rule: "min_items", params: []string{"2"}, value: []interface{}{"a", "b"}, want: true},
+		{rule: "min_items", params: []string{"2"}, value: []interface{}{"a"}, want: false},
+		{rule: "min_items", params: []string{"2"}, value: "ab", want: false},
+		{rule: "max_items", params: []string{"1"}, value: []string{"a"}, want: true},
+		{rule: "max_items", params: []string{"1"}, value: []interface{}{"a", "b"}, want: false},
+		{rule: "unique", value: []interface{}{"a", "b", json.Number("1")}, want: true},
+		{rule: "unique", value: []interface{}{"1", json.Number("1")}, want: true},
+		{rule: "unique", value: []interface{}{json.Number("1"), json.Number("1.0")}, want: false},
+		{rule: "unique", value: []interface{}{nil, nil}, want: false},
+		{rule: "unique", value: []interface{}{map[string]interface{}{"a": "1", "b": "2"}, map[string]interface{}{"b": "2", "a": "1"}}, want: false},
+		{rule: "unique", value: []int{1, 2, 1}, want: false},
+		{rule: "unique", params: []string{"userId"}, value: objects, want: true},
+		{rule: "unique", params: []string{"name"}, value: objects, want: false},
+		{rule: "contains_value", params: []string{"admin"}, value: []interface{}{"user", "admin"}, want: true},
+		{rule: "contains_value", params: []string{"1.5"}, value: []interface{}{json.Number("1.50")}, want: true},
+		{rule: "contains_value", params: []string{"true"}, value: []interface{}{true}, want: true},
+		{rule: "contains_value", params: []string{"admin"}, value: []interface{}{"Admin"}, want: false},
+		{rule: "contains_value", params: []string{"admin"}, value: "admin", want: false},
+		{rule: "subset_of", params: []string{"read", "write"}, value: []interface{}{"write", "read", "write"}, want: true},
+		{rule: "subset_of", params: []string{"read", "write"}, value: []interface{}{}, want: true},
+		{rule: "subset_of", params: []string{"read", "write"}, value: []interface{}{"read", "admin"}, want: false},
+		{rule: "subset_of", params: []string{"1", "2"}, value: []interface{}{json.Number("2"), json.Number("1e0")}, want: true},
+		{rule: "sorted", value: []interface{}{json.Number("1"), json.Number("1"), json.Number("2.5"), json.Number("10")}, want: true},
+		{rule: "sorted", value: []interface{}{"a", "b", "ba"}, want: true},
+		{rule: "sorted", value: []interface{}{"b", "a"}, want: false},
+		{rule: "sorted", value: []interface{}{json.Number("1"), "2"}, want: false},
+		{rule: "sorted", value: []interface{}{true, false}, want: false},
+		{rule: "sorted", value: []float64{1, 2}, want: true},
+		{rule: "sorted_desc", value: []interface{}{json.Number("10"), json.Number("9")}, want: true},
+		{rule: "sorted_desc", value: []interface{}{"a", "b"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s:%v(%v) = %v, want %v", tt.rule, tt.params, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+//go:build ignore
+
+// Generate writes code tables of handlers package from JSON files of Debian iso-codes project
+// (https://salsa.debian.org/iso-codes-team/iso-codes), installed by "iso-codes" package to /usr/share/iso-codes/json.
+//
+// Usage: go run data/generate.go [iso-codes json directory]
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const defaultSource = "/usr/share/iso-codes/json"
+
+func main() {
+	source := defaultSource
+	if len(os.Args) > 1 {
+		source = os.Args[1]
+	}
+
+	countries := read(source, "iso_3166-1.json", "3166-1")
+	currencies := read(source, "iso_4217.json", "4217")
+	languages := read(source, "iso_639-2.json", "639-2")
+
+	rows := make([]string, 0, len(countries))
+	for _, country := range countries {
+		rows = append(rows, strings.Join([]string{country["alpha_2"], country["alpha_3"], country["numeric"]}, "\t"))
+	}
+
+	write("iso3166.tsv", rows)
+	write("iso4217.txt", column(currencies, "alpha_3"))
+	write("iso639_1.txt", column(languages, "alpha_2"))
+}
+
+func read(source, file, key string) []map[string]string {
+	raw, err := os.ReadFile(filepath.Join(source, file))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	table := make(map[string][]map[string]string)
+
+	err = json.Unmarshal(raw, &table)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return table[key]
+}
+
+func column(entries []map[string]string, key string) []string {
+	result := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if value := entry[key]; value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func write(file string, rows []string) {
+	slices.Sort(rows)
+
+	err := os.WriteFile(filepath.Join("data", file), []byte(strings.Join(rows, "\n")+"\n"), 0o644) //nolint:gosec,mnd
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%s: %d codes\n", file, len(rows)) //nolint:forbidigo
+}
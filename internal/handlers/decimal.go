@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// IsDecimal is the validation function for validating if the current field's value is decimal with at most
+// params[0] digits in total and at most params[1] digits after point, "decimal:12,2" accepts "9999999999.99".
+// Leading zeros of integer part and trailing zeros of fraction are not counted.
+func IsDecimal(field reflect.Value, params []string) bool {
+	if len(params) < 2 { //nolint:mnd
+		panic(fmt.Errorf("%w: decimal expects 2 arguments", validation.ErrInvalidRuleArgs))
+	}
+
+	maxDigits := asInt(params[0])
+	maxFractionDigits := asInt(params[1])
+
+	text, ok := decimalText(field)
+	if !ok {
+		return false
+	}
+
+	integerDigits, fractionDigits, ok := countDecimalDigits(text)
+	if !ok {
+		return false
+	}
+
+	return integerDigits+fractionDigits <= maxDigits && fractionDigits <= maxFractionDigits
+}
+
+// IsMultipleOf is the validation function for validating if the current field's value is exact multiple of the param's value,
+// "multiple_of:0.05" accepts "1.15".
+func IsMultipleOf(field reflect.Value, params []string) bool {
+	if len(params) < 1 {
+		return false
+	}
+
+	step := asRat(params[0])
+	if step.Sign() == 0 {
+		panic(fmt.Errorf("%w: multiple_of expects non zero argument", validation.ErrInvalidRuleArgs))
+	}
+
+	number, ok := decimalOf(field)
+	if !ok {
+		return false
+	}
+
+	return new(big.Rat).Quo(number, step).IsInt()
+}
+
+// HasDecimalMinOf is the validation function for validating if the current field's value is greater than or equal to the param's value.
+// Unlike min, numeric string is compared by its value, not by its length.
+func HasDecimalMinOf(field reflect.Value, params []string) bool {
+	if len(params) < 1 {
+		return false
+	}
+
+	number, ok := decimalOf(field)
+
+	return ok && number.Cmp(asRat(params[0])) >= 0
+}
+
+// HasDecimalMaxOf is the validation function for validating if the current field's value is less than or equal to the param's value.
+// Unlike max, numeric string is compared by its value, not by its length.
+func HasDecimalMaxOf(field reflect.Value, params []string) bool {
+	if len(params) < 1 {
+		return false
+	}
+
+	number, ok := decimalOf(field)
+
+	return ok && number.Cmp(asRat(params[0])) <= 0
+}
+
+// decimalOf returns exact value of json number, numeric string or go number.
+func decimalOf(field reflect.Value) (*big.Rat, bool) {
+	text, ok := decimalText(field)
+	if !ok {
+		return nil, false
+	}
+
+	return parseNumber(text)
+}
+
+// decimalText returns text of number as it was written. Go floats are written in the shortest form which reads back to the same float.
+// Values of other kinds are not numbers.
+func decimalText(field reflect.Value) (string, bool) {
+	switch field.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return field.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64), true
+	}
+
+	return "", false
+}
+
+// countDecimalDigits counts significant digits of integer part and of fraction of decimal text, exponent is applied.
+func countDecimalDigits(text string) (int64, int64, bool) {
+	if _, ok := parseNumber(text); !ok {
+		return 0, 0, false
+	}
+
+	mantissa, exponentText, _ := strings.Cut(strings.ToLower(strings.TrimLeft(text, "+-")), "e")
+
+	exponent := 0
+	if exponentText != "" {
+		exponent, _ = strconv.Atoi(exponentText)
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	digits := integer + fraction
+	point := len(integer) + exponent
+
+	switch {
+	case point <= 0:
+		integer, fraction = "", strings.Repeat("0", -point)+digits
+	case point >= len(digits):
+		integer, fraction = digits+strings.Repeat("0", point-len(digits)), ""
+	default:
+		integer, fraction = digits[:point], digits[point:]
+	}
+
+	return int64(len(strings.TrimLeft(integer, "0"))), int64(len(strings.TrimRight(fraction, "0"))), true
+}
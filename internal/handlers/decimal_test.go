@@ -0,0 +1,81 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		handler validation.RuleHandlerFunc
+		value   interface{}
+		params  []string
+		want    bool
+	}{
+		{name: "decimal fits", handler: handlers.IsDecimal, value: json.Number("9999999999.99"), params: []string{"12", "2"}, want: true},
+		{name: "decimal too many fraction digits", handler: handlers.IsDecimal, value: json.Number("1.005"), params: []string{"12", "2"}, want: false},
+		{name: "decimal too many digits", handler: handlers.IsDecimal, value: "12345678901.23", params: []string{"12", "2"}, want: false},
+		{name: "decimal zeros are not significant", handler: handlers.IsDecimal, value: "-000123.4500", params: []string{"5", "2"}, want: true},
+		{name: "decimal exponent", handler: handlers.IsDecimal, value: json.Number("1.5e-3"), params: []string{"12", "2"}, want: false},
+		{name: "decimal positive exponent", handler: handlers.IsDecimal, value: json.Number("1.25e2"), params: []string{"3", "0"}, want: true},
+		{name: "decimal not a number", handler: handlers.IsDecimal, value: "12,50", params: []string{"12", "2"}, want: false},
+		{name: "decimal go float", handler: handlers.IsDecimal, value: 0.1, params: []string{"1", "1"}, want: true},
+		{name: "multiple_of", handler: handlers.IsMultipleOf, value: json.Number("1.15"), params: []string{"0.05"}, want: true},
+		{name: "multiple_of not", handler: handlers.IsMultipleOf, value: "1.17", params: []string{"0.05"}, want: false},
+		{name: "multiple_of int", handler: handlers.IsMultipleOf, value: 300, params: []string{"100"}, want: true},
+		{name: "decimal_min exact", handler: handlers.HasDecimalMinOf, value: "0.30000000000000001", params: []string{"0.3"}, want: true},
+		{name: "decimal_min below", handler: handlers.HasDecimalMinOf, value: json.Number("0.009"), params: []string{"0.01"}, want: false},
+		{name: "decimal_max string by value", handler: handlers.HasDecimalMaxOf, value: "1000.00", params: []string{"1000"}, want: true},
+		{name: "decimal_max above", handler: handlers.HasDecimalMaxOf, value: "1000.01", params: []string{"1000"}, want: false},
+		{name: "decimal_max not a number", handler: handlers.HasDecimalMaxOf, value: "1/2", params: []string{"1000"}, want: false},
+		{name: "decimal bool", handler: handlers.IsDecimal, value: true, params: []string{"12", "2"}, want: false},
+		{name: "decimal object", handler: handlers.IsDecimal, value: map[string]interface{}{"amount": 1}, params: []string{"12", "2"}, want: false},
+		{name: "multiple_of array", handler: handlers.IsMultipleOf, value: []interface{}{json.Number("10")}, params: []string{"5"}, want: false},
+		{name: "decimal_min null", handler: handlers.HasDecimalMinOf, value: nil, params: []string{"0"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.handler(reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("handler(%v, %v) = %v, want %v", tt.value, tt.params, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		handler validation.RuleHandlerFunc
+		params  []string
+	}{
+		{name: "decimal without scale", handler: handlers.IsDecimal, params: []string{"12"}},
+		{name: "multiple_of zero", handler: handlers.IsMultipleOf, params: []string{"0"}},
+		{name: "decimal_min not a number", handler: handlers.HasDecimalMinOf, params: []string{"abc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, validation.ErrInvalidRuleArgs) {
+					t.Errorf("panic = %v, want error wrapping %v", err, validation.ErrInvalidRuleArgs)
+				}
+			}()
+
+			tt.handler(reflect.ValueOf(json.Number("1")), tt.params)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+const (
+	hoursPerDay = 24
+	daysPerWeek = 7
+)
+
+// isoDurationRegex matches ISO 8601 duration of weeks ("P2W") or days and time ("P1DT2H30M", "PT0.5S").
+// Years and months have no fixed length, so they are not supported.
+var isoDurationRegex = regexp.MustCompile(`^P(?:(\d+)W|(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?)$`)
+
+// IsDuration is the validation function for validating if the current field's value is Go duration string ("1h30m")
+// or ISO 8601 duration string ("PT1H30M"), see ParseDuration. time.Duration value passes too.
+func IsDuration(field reflect.Value, _ []string) bool {
+	_, ok := durationOf(field)
+
+	return ok
+}
+
+// HasDurationMinOf is the validation function for validating if the current field's value is duration
+// greater than or equal to the param's duration, "duration_min:1m" or "duration_min:PT1M".
+func HasDurationMinOf(field reflect.Value, params []string) bool {
+	limit := asDuration(params)
+	value, ok := durationOf(field)
+
+	return ok && value >= limit
+}
+
+// HasDurationMaxOf is the validation function for validating if the current field's value is duration
+// less than or equal to the param's duration, "duration_max:24h" or "duration_max:P1D".
+func HasDurationMaxOf(field reflect.Value, params []string) bool {
+	limit := asDuration(params)
+	value, ok := durationOf(field)
+
+	return ok && value <= limit
+}
+
+// ParseDuration parses Go duration ("1h30m", "-90s") or ISO 8601 duration of weeks, days and time ("P1DT2H", "PT0.5S").
+// ISO 8601 day is 24 hours.
+func ParseDuration(text string) (time.Duration, bool) {
+	if !strings.HasPrefix(text, "P") {
+		duration, err := time.ParseDuration(text)
+
+		return duration, err == nil
+	}
+
+	matches := isoDurationRegex.FindStringSubmatch(text)
+	if matches == nil || text == "P" || strings.HasSuffix(text, "T") {
+		return 0, false
+	}
+
+	weeks, days, hours, minutes, seconds := matches[1], matches[2], matches[3], matches[4], matches[5]
+
+	totalDays, ok := dayCount(weeks, days)
+	if !ok {
+		return 0, false
+	}
+
+	goDuration := fmt.Sprintf("%dh%sh%sm%ss", totalDays*hoursPerDay, zeroIfEmpty(hours), zeroIfEmpty(minutes), zeroIfEmpty(strings.Replace(seconds, ",", ".", 1)))
+
+	duration, err := time.ParseDuration(goDuration)
+
+	return duration, err == nil
+}
+
+// dayCount returns count of days in weeks or days component, which fits into time.Duration.
+func dayCount(weeks, days string) (int64, bool) {
+	count, multiplier := days, int64(1)
+	if weeks != "" {
+		count, multiplier = weeks, daysPerWeek
+	}
+
+	if count == "" {
+		return 0, true
+	}
+
+	value, err := strconv.ParseInt(count, 10, 64)
+	if err != nil || value > int64(math.MaxInt64/(hoursPerDay*time.Hour))/multiplier {
+		return 0, false
+	}
+
+	return value * multiplier, true
+}
+
+func zeroIfEmpty(text string) string {
+	if text == "" {
+		return "0"
+	}
+
+	return text
+}
+
+// asDuration returns the first parameter as duration
+// or panics if it can't convert.
+func asDuration(params []string) time.Duration {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: duration rule expects 1 argument", validation.ErrInvalidRuleArgs))
+	}
+
+	duration, ok := ParseDuration(params[0])
+	if !ok {
+		panic(fmt.Errorf("%w: %q is not duration", validation.ErrInvalidRuleArgs, params[0]))
+	}
+
+	return duration
+}
+
+func durationOf(field reflect.Value) (time.Duration, bool) {
+	if field.Type() == timeDurationType {
+		return time.Duration(field.Int()), true
+	}
+
+	if field.Kind() != reflect.String || isJSONNumber(field) {
+		return 0, false
+	}
+
+	return ParseDuration(field.String())
+}
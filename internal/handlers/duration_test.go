@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+func TestDurationHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule   string
+		params []string
+		value  interface{}
+		want   bool
+	}{
+		{rule: "duration", value: "1h30m", want: true},
+		{rule: "duration", value: "-90s", want: true},
+		{rule: "duration", value: "PT1H30M", want: true},
+		{rule: "duration", value: "P1DT12H", want: true},
+		{rule: "duration", value: "P2W", want: true},
+		{rule: "duration", value: "PT0,5S", want: true},
+		{rule: "duration", value: time.Minute, want: true},
+		{rule: "duration", value: "P", want: false},
+		{rule: "duration", value: "PT", want: false},
+		{rule: "duration", value: "P1D2H", want: false},
+		{rule: "duration", value: "P1Y", want: false},
+		{rule: "duration", value: "P1W2D", want: false},
+		{rule: "duration", value: "P999999999DT1H", want: false},
+		{rule: "duration", value: "90", want: false},
+		{rule: "duration", value: json.Number("90"), want: false},
+		{rule: "duration_min", params: []string{"1m"}, value: "PT60S", want: true},
+		{rule: "duration_min", params: []string{"PT1M"}, value: "59s", want: false},
+		{rule: "duration_min", params: []string{"1m"}, value: "soon", want: false},
+		{rule: "duration_max", params: []string{"P1D"}, value: "24h", want: true},
+		{rule: "duration_max", params: []string{"24h"}, value: "P1DT1S", want: false},
+		{rule: "duration_max", params: []string{"24h"}, value: 25 * time.Hour, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s:%v(%v) = %v, want %v", tt.rule, tt.params, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text string
+		want time.Duration
+	}{
+		{text: "1h30m", want: 90 * time.Minute},
+		{text: "PT1H30M", want: 90 * time.Minute},
+		{text: "P1DT2H", want: 26 * time.Hour},
+		{text: "P1W", want: 7 * 24 * time.Hour},
+		{text: "PT1.5S", want: 1500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := handlers.ParseDuration(tt.text)
+			if !ok || got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, %v, want %v", tt.text, got, ok, tt.want)
+			}
+		})
+	}
+}
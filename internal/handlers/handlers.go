@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"reflect"
@@ -9,6 +10,8 @@ import (
 	"strings"
 	"time"
 	"unicode/utf8"
+
+	"github.com/thumbrise/validrator/internal/validation"
 )
 
 // HasLengthOf is the validation function for validating if the current field's value is equal to the param's value.
@@ -19,6 +22,12 @@ func HasLengthOf(field reflect.Value, params []string) bool {
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result == 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -55,6 +64,7 @@ func HasLengthOf(field reflect.Value, params []string) bool {
 }
 
 // IsLt is the validation function for validating if the current field's value is less than the param's value.
+// time.Time is compared with time param ("now", "now+24h", "2025-01-01"), see NewTimeHandlers.
 func IsLt(field reflect.Value, params []string) bool { //nolint:dupl
 	if len(params) < 1 {
 		return false
@@ -62,6 +72,12 @@ func IsLt(field reflect.Value, params []string) bool { //nolint:dupl
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result < 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -95,7 +111,7 @@ func IsLt(field reflect.Value, params []string) bool { //nolint:dupl
 
 	case reflect.Struct:
 		if field.Type().ConvertibleTo(timeType) {
-			return field.Convert(timeType).Interface().(time.Time).Before(time.Now().UTC()) //nolint:forcetypeassert
+			return field.Convert(timeType).Interface().(time.Time).Before(asTime(param, SystemClock)) //nolint:forcetypeassert
 		}
 	}
 
@@ -110,6 +126,12 @@ func IsGt(field reflect.Value, params []string) bool { //nolint:dupl
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result > 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -143,7 +165,7 @@ func IsGt(field reflect.Value, params []string) bool { //nolint:dupl
 
 	case reflect.Struct:
 		if field.Type().ConvertibleTo(timeType) {
-			return field.Convert(timeType).Interface().(time.Time).After(time.Now().UTC()) //nolint:forcetypeassert
+			return field.Convert(timeType).Interface().(time.Time).After(asTime(param, SystemClock)) //nolint:forcetypeassert
 		}
 	}
 
@@ -173,6 +195,10 @@ func IsHttpURL(field reflect.Value, params []string) bool { //nolint:revive,styl
 
 // IsURI is the validation function for validating if the current field's value is a valid URI.
 func IsURI(field reflect.Value, _ []string) bool {
+	if isJSONNumber(field) {
+		return false
+	}
+
 	switch field.Kind() { //nolint:gocritic,exhaustive
 	case reflect.String:
 		str := field.String()
@@ -203,11 +229,15 @@ func Contains(field reflect.Value, params []string) bool {
 
 	param := params[0]
 
-	return strings.Contains(field.String(), param)
+	return isText(field) && strings.Contains(field.String(), param)
 }
 
 // IsOneOf godoc.
 func IsOneOf(field reflect.Value, params []string) bool {
+	if isJSONNumber(field) {
+		return isNumberOneOf(field, params)
+	}
+
 	var val string
 
 	switch field.Kind() { //nolint:exhaustive
@@ -230,6 +260,23 @@ func IsOneOf(field reflect.Value, params []string) bool {
 	return false
 }
 
+// isNumberOneOf compares json number with every param exactly, so "1.50" is one of "1.5". Params which are not numbers never match.
+func isNumberOneOf(field reflect.Value, params []string) bool {
+	actual, ok := parseNumber(field.String())
+	if !ok {
+		return false
+	}
+
+	for _, param := range params {
+		expected, ok := parseNumber(param)
+		if ok && actual.Cmp(expected) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // isFileURL is the helper function for validating if the `path` valid file URL as per RFC8089.
 func isFileURL(path string) bool {
 	if !strings.HasPrefix(path, "file:/") {
@@ -243,6 +290,10 @@ func isFileURL(path string) bool {
 
 // IsNumber is the validation function for validating if the current field's value is a valid number.
 func IsNumber(field reflect.Value, _ []string) bool {
+	if isJSONNumber(field) {
+		return true
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
 		return true
@@ -253,6 +304,10 @@ func IsNumber(field reflect.Value, _ []string) bool {
 
 // IsBoolean is the validation function for validating if the current field's value is a valid boolean value or can be safely converted to a boolean value.
 func IsBoolean(field reflect.Value, _ []string) bool {
+	if isJSONNumber(field) {
+		return false
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.Bool:
 		return true
@@ -265,6 +320,10 @@ func IsBoolean(field reflect.Value, _ []string) bool {
 
 // IsURL is the validation function for validating if the current field's value is a valid URL.
 func IsURL(field reflect.Value, _ []string) bool {
+	if isJSONNumber(field) {
+		return false
+	}
+
 	switch field.Kind() { //nolint:gocritic,exhaustive
 	case reflect.String:
 		str := strings.ToLower(field.String())
@@ -294,12 +353,12 @@ func IsURL(field reflect.Value, _ []string) bool {
 
 // IsEmail is the validation function for validating if the current field's value is a valid email address.
 func IsEmail(field reflect.Value, _ []string) bool {
-	return emailRegex().MatchString(field.String())
+	return isText(field) && emailRegex().MatchString(field.String())
 }
 
 // IsAlphaUnicode is the validation function for validating if the current field's value is a valid alpha unicode value.
 func IsAlphaUnicode(field reflect.Value, _ []string) bool {
-	return alphaUnicodeRegex().MatchString(field.String())
+	return isText(field) && alphaUnicodeRegex().MatchString(field.String())
 }
 
 // HasMinOf is the validation function for validating if the current field's value is greater than or equal to the param's value.
@@ -313,12 +372,17 @@ func HasMaxOf(fl reflect.Value, params []string) bool {
 }
 
 // IsDatetime is the validation function for validating if the current field's value is a valid datetime string.
+// Param is Go layout or name of layout constant of time package: "datetime:RFC3339", "datetime:DateOnly".
 func IsDatetime(field reflect.Value, params []string) bool {
 	if len(params) < 1 {
 		return false
 	}
 
-	param := params[0]
+	if isJSONNumber(field) {
+		return false
+	}
+
+	param := asLayout(params[0])
 
 	if field.Kind() == reflect.String {
 		_, err := time.Parse(param, field.String())
@@ -331,13 +395,13 @@ func IsDatetime(field reflect.Value, params []string) bool {
 
 // IsJWT is the validation function for validating if the current field's value is a valid JWT string.
 func IsJWT(field reflect.Value, _ []string) bool {
-	return jWTRegex().MatchString(field.String())
+	return isText(field) && jWTRegex().MatchString(field.String())
 }
 
 // Bool validate false, true, 1, 0, "true", "false", "0", "1".
 func Bool(v reflect.Value, _ []string) bool {
 	switch v.Interface() {
-	case 1, 0, false, true, "true", "false", "0", "1":
+	case 1, 0, false, true, "true", "false", "0", "1", json.Number("0"), json.Number("1"):
 		return true
 	}
 
@@ -347,11 +411,17 @@ func Bool(v reflect.Value, _ []string) bool {
 // Len validate length for next types: String, Slice, Map, Array, Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr, Float32, Float64.
 func Len(val reflect.Value, args []string) bool {
 	if len(args) < 1 {
-		panic("Len expects 1 argument")
+		panic(fmt.Errorf("%w: Len expects 1 argument", validation.ErrInvalidRuleArgs))
 	}
 
 	param := args[0]
 
+	if isJSONNumber(val) {
+		result, ok := compareNumber(val, param)
+
+		return ok && result == 0
+	}
+
 	switch val.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -395,6 +465,12 @@ func IsGte(field reflect.Value, params []string) bool { //nolint:cyclop
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result >= 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -428,10 +504,9 @@ func IsGte(field reflect.Value, params []string) bool { //nolint:cyclop
 
 	case reflect.Struct:
 		if field.Type().ConvertibleTo(timeType) {
-			now := time.Now().UTC()
 			t := field.Convert(timeType).Interface().(time.Time) //nolint:forcetypeassert
 
-			return t.After(now) || t.Equal(now)
+			return !t.Before(asTime(param, SystemClock))
 		}
 	}
 
@@ -446,6 +521,12 @@ func IsLte(field reflect.Value, params []string) bool { //nolint:cyclop
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result <= 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		p := asInt(param)
@@ -479,11 +560,9 @@ func IsLte(field reflect.Value, params []string) bool { //nolint:cyclop
 
 	case reflect.Struct:
 		if field.Type().ConvertibleTo(timeType) {
-			now := time.Now().UTC()
-
 			t, ok := field.Convert(timeType).Interface().(time.Time)
 			if ok {
-				return t.Before(now) || t.Equal(now)
+				return !t.After(asTime(param, SystemClock))
 			}
 		}
 	}
@@ -504,6 +583,12 @@ func IsEq(field reflect.Value, params []string) bool {
 
 	param := params[0]
 
+	if isJSONNumber(field) {
+		result, ok := compareNumber(field, param)
+
+		return ok && result == 0
+	}
+
 	switch field.Kind() { //nolint:exhaustive
 	case reflect.String:
 		return field.String() == param
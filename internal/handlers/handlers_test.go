@@ -1,6 +1,8 @@
 package handlers_test
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/thumbrise/validrator/internal/handlers"
@@ -83,3 +85,45 @@ func TestBool(t *testing.T) {
 		}
 	})
 }
+
+func TestNumberPrecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		handler validation.RuleHandlerFunc
+		value   json.Number
+		params  []string
+		want    bool
+	}{
+		{name: "eq above 2^53", handler: handlers.IsEq, value: "9007199254740993", params: []string{"9007199254740993"}, want: true},
+		{name: "eq neighbour above 2^53", handler: handlers.IsEq, value: "9007199254740993", params: []string{"9007199254740992"}, want: false},
+		{name: "ne neighbour above 2^53", handler: handlers.IsNe, value: "9007199254740993", params: []string{"9007199254740992"}, want: true},
+		{name: "eq different notation", handler: handlers.IsEq, value: "1.50", params: []string{"15e-1"}, want: true},
+		{name: "min exact fraction", handler: handlers.HasMinOf, value: "0.30000000000000001", params: []string{"0.3"}, want: true},
+		{name: "max exact fraction", handler: handlers.HasMaxOf, value: "0.30000000000000001", params: []string{"0.3"}, want: false},
+		{name: "lt big amount", handler: handlers.IsLt, value: "12345678901234567.89", params: []string{"12345678901234567.9"}, want: true},
+		{name: "gt big amount", handler: handlers.IsGt, value: "12345678901234567.89", params: []string{"12345678901234567.9"}, want: false},
+		{name: "gte equal", handler: handlers.IsGte, value: "-5", params: []string{"-5.0"}, want: true},
+		{name: "lte huge exponent", handler: handlers.IsLte, value: "1e1000000000", params: []string{"1"}, want: false},
+		{name: "oneof above 2^53", handler: handlers.IsOneOf, value: "9007199254740993", params: []string{"9007199254740992", "9007199254740993"}, want: true},
+		{name: "oneof no match", handler: handlers.IsOneOf, value: "9007199254740993", params: []string{"9007199254740992", "a"}, want: false},
+		{name: "number", handler: handlers.IsNumber, value: "-1.5e3", want: true},
+		{name: "bool", handler: handlers.Bool, value: "1", want: true},
+		{name: "contains is string rule", handler: handlers.Contains, value: "123", params: []string{"2"}, want: false},
+		{name: "email is string rule", handler: handlers.IsEmail, value: "1e3", want: false},
+		{name: "alphaunicode is string rule", handler: handlers.IsAlphaUnicode, value: "1", want: false},
+		{name: "url is string rule", handler: handlers.IsURL, value: "1", want: false},
+		{name: "uri is string rule", handler: handlers.IsURI, value: "1", want: false},
+		{name: "datetime is string rule", handler: handlers.IsDatetime, value: "2006", params: []string{"2006"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.handler(reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("handler(%s, %v) = %v, want %v", tt.value, tt.params, got, tt.want)
+			}
+		})
+	}
+}
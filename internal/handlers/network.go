@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+const maxPort = 65535
+
+// nonPublicPrefixes are special-purpose ranges of IANA registries which are not globally reachable,
+// in addition to ones detected by methods of netip.Addr. IPv6 ranges embedding IPv4 address are listed too,
+// because they reach the embedded address, which may be internal one: IPv4-compatible ::/96, NAT64 64:ff9b::/96
+// and 64:ff9b:1::/48, 6to4 2002::/16.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("::/96"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// IsIP is the validation function for validating if the current field's value is IPv4 or IPv6 address.
+func IsIP(field reflect.Value, _ []string) bool {
+	_, ok := addrOf(field)
+
+	return ok
+}
+
+// IsIPv4 is the validation function for validating if the current field's value is IPv4 address.
+func IsIPv4(field reflect.Value, _ []string) bool {
+	addr, ok := addrOf(field)
+
+	return ok && addr.Is4()
+}
+
+// IsIPv6 is the validation function for validating if the current field's value is IPv6 address, IPv4-mapped addresses included.
+func IsIPv6(field reflect.Value, _ []string) bool {
+	addr, ok := addrOf(field)
+
+	return ok && addr.Is6()
+}
+
+// IsCIDR is the validation function for validating if the current field's value is IP prefix in CIDR notation (10.0.0.0/8).
+func IsCIDR(field reflect.Value, _ []string) bool {
+	_, ok := prefixOf(field)
+
+	return ok
+}
+
+// IsCIDRv4 is the validation function for validating if the current field's value is IPv4 prefix in CIDR notation.
+func IsCIDRv4(field reflect.Value, _ []string) bool {
+	prefix, ok := prefixOf(field)
+
+	return ok && prefix.Addr().Is4()
+}
+
+// IsCIDRv6 is the validation function for validating if the current field's value is IPv6 prefix in CIDR notation.
+func IsCIDRv6(field reflect.Value, _ []string) bool {
+	prefix, ok := prefixOf(field)
+
+	return ok && prefix.Addr().Is6()
+}
+
+// IsMAC is the validation function for validating if the current field's value is IEEE 802 MAC-48, EUI-48, EUI-64 or 20-octet IP over InfiniBand address.
+func IsMAC(field reflect.Value, _ []string) bool {
+	if !isText(field) {
+		return false
+	}
+
+	_, err := net.ParseMAC(field.String())
+
+	return err == nil
+}
+
+// IsPort is the validation function for validating if the current field's value is port number from 1 to 65535.
+func IsPort(field reflect.Value, _ []string) bool {
+	switch field.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return isPortText(field.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() >= 1 && field.Int() <= maxPort
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.Uint() >= 1 && field.Uint() <= maxPort
+	}
+
+	return false
+}
+
+// IsHostnamePort is the validation function for validating if the current field's value is "host:port" pair,
+// where host is RFC 1123 hostname or IP address ("[::1]:80" for IPv6) and may be empty (":8080").
+func IsHostnamePort(field reflect.Value, _ []string) bool {
+	if !isText(field) {
+		return false
+	}
+
+	host, port, err := net.SplitHostPort(field.String())
+	if err != nil || !isPortText(port) {
+		return false
+	}
+
+	if host == "" {
+		return true
+	}
+
+	if _, err := netip.ParseAddr(host); err == nil {
+		return true
+	}
+
+	return hostnameRegexRFC1123().MatchString(host)
+}
+
+// IsIPIn is the validation function for validating if the current field's value is IP address inside of any prefix of params,
+// "ip_in:10.0.0.0/8,192.168.0.0/16". IPv4-mapped IPv6 address is checked as IPv4 address.
+func IsIPIn(field reflect.Value, params []string) bool {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: ip_in expects at least 1 prefix", validation.ErrInvalidRuleArgs))
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(params))
+
+	for _, param := range params {
+		prefix, err := netip.ParsePrefix(param)
+		panicIf(err)
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	addr, ok := addrOf(field)
+	if !ok {
+		return false
+	}
+
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPublicIP is the validation function for validating if the current field's value is globally reachable IP address.
+// Private, loopback, link-local, multicast, unspecified, documentation and other special-purpose addresses are not public.
+func IsPublicIP(field reflect.Value, _ []string) bool {
+	addr, ok := addrOf(field)
+
+	return ok && isPublicAddr(addr)
+}
+
+// IsPrivateIP is the validation function for validating if the current field's value is private IP address
+// according to RFC 1918 (IPv4) or RFC 4193 (IPv6).
+func IsPrivateIP(field reflect.Value, _ []string) bool {
+	addr, ok := addrOf(field)
+
+	return ok && addr.Unmap().IsPrivate()
+}
+
+// isPublicAddr reports whether addr is globally reachable.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func addrOf(field reflect.Value) (netip.Addr, bool) {
+	if !isText(field) {
+		return netip.Addr{}, false
+	}
+
+	addr, err := netip.ParseAddr(field.String())
+
+	return addr, err == nil
+}
+
+func prefixOf(field reflect.Value) (netip.Prefix, bool) {
+	if !isText(field) {
+		return netip.Prefix{}, false
+	}
+
+	prefix, err := netip.ParsePrefix(field.String())
+
+	return prefix, err == nil
+}
+
+func isPortText(text string) bool {
+	port, err := strconv.ParseUint(text, 10, 16)
+
+	return err == nil && port >= 1
+}
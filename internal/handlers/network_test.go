@@ -0,0 +1,93 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestNetworkHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule   string
+		value  interface{}
+		params []string
+		want   bool
+	}{
+		{rule: "ip", value: "192.168.0.1", want: true},
+		{rule: "ip", value: "2001:db8::1", want: true},
+		{rule: "ip", value: "256.0.0.1", want: false},
+		{rule: "ip", value: 1, want: false},
+		{rule: "ip", value: json.Number("1"), want: false},
+		{rule: "ipv4", value: "10.0.0.1", want: true},
+		{rule: "ipv4", value: "::ffff:10.0.0.1", want: false},
+		{rule: "ipv6", value: "::1", want: true},
+		{rule: "ipv6", value: "10.0.0.1", want: false},
+		{rule: "cidr", value: "10.0.0.0/8", want: true},
+		{rule: "cidr", value: "10.0.0.0", want: false},
+		{rule: "cidr", value: json.Number("10"), want: false},
+		{rule: "cidrv4", value: "10.0.0.0/8", want: true},
+		{rule: "cidrv4", value: "fd00::/8", want: false},
+		{rule: "cidrv6", value: "fd00::/8", want: true},
+		{rule: "cidrv6", value: "10.0.0.0/33", want: false},
+		{rule: "mac", value: "00:1A:2b:3c:4D:5e", want: true},
+		{rule: "mac", value: "00:1A:2b:3c:4D", want: false},
+		{rule: "mac", value: json.Number("1"), want: false},
+		{rule: "port", value: json.Number("8080"), want: true},
+		{rule: "port", value: "65535", want: true},
+		{rule: "port", value: 0, want: false},
+		{rule: "port", value: json.Number("65536"), want: false},
+		{rule: "hostname_port", value: "example.com:443", want: true},
+		{rule: "hostname_port", value: "[::1]:80", want: true},
+		{rule: "hostname_port", value: json.Number("80"), want: false},
+		{rule: "hostname_port", value: ":8080", want: true},
+		{rule: "hostname_port", value: "example.com", want: false},
+		{rule: "hostname_port", value: "exa_mple.com:80", want: false},
+		{rule: "hostname_port", value: "example.com:0", want: false},
+		{rule: "ip_in", value: "10.1.2.3", params: []string{"10.0.0.0/8"}, want: true},
+		{rule: "ip_in", value: "::ffff:192.168.1.1", params: []string{"10.0.0.0/8", "192.168.0.0/16"}, want: true},
+		{rule: "ip_in", value: "172.16.0.1", params: []string{"10.0.0.0/8", "192.168.0.0/16"}, want: false},
+		{rule: "public_ip", value: "8.8.8.8", want: true},
+		{rule: "public_ip", value: "2606:4700:4700::1111", want: true},
+		{rule: "public_ip", value: "10.0.0.1", want: false},
+		{rule: "public_ip", value: "127.0.0.1", want: false},
+		{rule: "public_ip", value: "169.254.169.254", want: false},
+		{rule: "public_ip", value: "100.64.0.1", want: false},
+		{rule: "public_ip", value: "::ffff:127.0.0.1", want: false},
+		{rule: "public_ip", value: "2001:db8::1", want: false},
+		{rule: "public_ip", value: "::a9fe:a9fe", want: false},
+		{rule: "public_ip", value: "64:ff9b::a9fe:a9fe", want: false},
+		{rule: "public_ip", value: "64:ff9b:1::a00:1", want: false},
+		{rule: "public_ip", value: "2002:a9fe:a9fe::", want: false},
+		{rule: "private_ip", value: "192.168.1.1", want: true},
+		{rule: "private_ip", value: "fd12:3456::1", want: true},
+		{rule: "private_ip", value: "8.8.8.8", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s(%v, %v) = %v, want %v", tt.rule, tt.value, tt.params, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, validation.ErrInvalidRuleArgs) {
+			t.Errorf("panic = %v, want error wrapping %v", err, validation.ErrInvalidRuleArgs)
+		}
+	}()
+
+	handlers.IsIPIn(reflect.ValueOf("10.0.0.1"), []string{"10.0.0.0/33"})
+}
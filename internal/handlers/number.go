@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxNumberExponent bounds exponent of exact numbers, because big.Rat expands "1e1000000000" to all of its digits.
+const maxNumberExponent = 10000
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// isJSONNumber reports whether field holds number decoded as json.Number, such number is compared exactly.
+func isJSONNumber(field reflect.Value) bool {
+	return field.Type() == jsonNumberType
+}
+
+// isText reports whether field holds string. Json number has string kind too, but it is number, so string rules reject it.
+func isText(field reflect.Value) bool {
+	return field.Kind() == reflect.String && !isJSONNumber(field)
+}
+
+// parseNumber parses decimal number text like "12.50" or "-1e3" exactly.
+// Number with exponent out of maxNumberExponent is reported as unparsable.
+func parseNumber(text string) (*big.Rat, bool) {
+	if !decimalRegex().MatchString(text) {
+		return nil, false
+	}
+
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(text[i+1:])
+		if err != nil || exponent > maxNumberExponent || exponent < -maxNumberExponent {
+			return nil, false
+		}
+	}
+
+	number, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return nil, false
+	}
+
+	return number, true
+}
+
+// asRat returns the parameter as exact number
+// or panics if it can't convert.
+func asRat(param string) *big.Rat {
+	number, ok := parseNumber(param)
+	if !ok {
+		panicIf(&strconv.NumError{Func: "parseNumber", Num: param, Err: strconv.ErrSyntax})
+	}
+
+	return number
+}
+
+// compareNumber compares json number of field with param without rounding.
+// It returns false when number of field can not be represented exactly.
+func compareNumber(field reflect.Value, param string) (int, bool) {
+	expected := asRat(param)
+
+	actual, ok := parseNumber(field.String())
+	if !ok {
+		return 0, false
+	}
+
+	return actual.Cmp(expected), true
+}
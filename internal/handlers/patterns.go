@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"reflect"
+	"regexp"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// patternHandlers are rules which only match value against pattern of regexes.go.
+var patternHandlers = map[string]validation.RuleHandlerFunc{
+	"alpha":              matchesAny(alphaRegex),
+	"alphanum":           matchesAny(alphaNumericRegex),
+	"alphanumunicode":    matchesAny(alphaUnicodeNumericRegex),
+	"numeric":            matchesNumeric(numericRegex),
+	"hexadecimal":        matchesAny(hexadecimalRegex),
+	"hexcolor":           matchesAny(hexColorRegex),
+	"rgb":                matchesAny(rgbRegex),
+	"rgba":               matchesAny(rgbaRegex),
+	"hsl":                matchesAny(hslRegex),
+	"hsla":               matchesAny(hslaRegex),
+	"e164":               matchesAny(e164Regex),
+	"base32":             matchesAny(base32Regex),
+	"base64":             matchesAny(base64Regex),
+	"base64url":          matchesAny(base64URLRegex),
+	"base64rawurl":       matchesAny(base64RawURLRegex),
+	"uuid":               matchesAny(uUIDRegex),
+	"uuid3":              matchesAny(uUID3Regex),
+	"uuid4":              matchesAny(uUID4Regex),
+	"uuid5":              matchesAny(uUID5Regex),
+	"uuid_rfc4122":       matchesAny(uUIDRFC4122Regex),
+	"uuid3_rfc4122":      matchesAny(uUID3RFC4122Regex),
+	"uuid4_rfc4122":      matchesAny(uUID4RFC4122Regex),
+	"uuid5_rfc4122":      matchesAny(uUID5RFC4122Regex),
+	"ulid":               matchesAny(uLIDRegex),
+	"md4":                matchesAny(md4Regex),
+	"md5":                matchesAny(md5Regex),
+	"sha256":             matchesAny(sha256Regex),
+	"sha384":             matchesAny(sha384Regex),
+	"sha512":             matchesAny(sha512Regex),
+	"ripemd128":          matchesAny(ripemd128Regex),
+	"ripemd160":          matchesAny(ripemd160Regex),
+	"tiger128":           matchesAny(tiger128Regex),
+	"tiger160":           matchesAny(tiger160Regex),
+	"tiger192":           matchesAny(tiger192Regex),
+	"ascii":              matchesAny(aSCIIRegex),
+	"printascii":         matchesAny(printableASCIIRegex),
+	"multibyte":          matchesAny(multibyteRegex),
+	"datauri":            matchesAny(dataURIRegex),
+	"latitude":           matchesNumeric(latitudeRegex),
+	"longitude":          matchesNumeric(longitudeRegex),
+	"ssn":                matchesAny(sSNRegex),
+	"hostname":           matchesAny(hostnameRegexRFC952),
+	"hostname_rfc1123":   matchesAny(hostnameRegexRFC1123),
+	"fqdn":               matchesAny(fqdnRegexRFC1123),
+	"btc_addr":           matchesAny(btcAddressRegex),
+	"btc_addr_bech32":    matchesAny(btcLowerAddressRegexBech32, btcUpperAddressRegexBech32),
+	"eth_addr":           matchesAny(ethAddressRegex),
+	"url_encoded":        matchesAny(uRLEncodedRegex),
+	"html_encoded":       matchesAny(hTMLEncodedRegex),
+	"html":               matchesAny(hTMLRegex),
+	"bic":                matchesAny(bicRegex),
+	"semver":             matchesAny(semverRegex),
+	"dns_rfc1035_label":  matchesAny(dnsRegexRFC1035Label),
+	"cve":                matchesAny(cveRegex),
+	"mongodb":            matchesAny(mongodbConnectionRegex),
+	"cron":               matchesAny(cronRegex),
+	"spicedb_id":         matchesAny(spicedbIDRegex),
+	"spicedb_permission": matchesAny(spicedbPermissionRegex),
+	"spicedb_type":       matchesAny(spicedbTypeRegex),
+}
+
+// matchesAny returns handler validating that string matches any of regexes. Other kinds, json numbers too, never match.
+func matchesAny(regexes ...func() *regexp.Regexp) validation.RuleHandlerFunc {
+	return func(field reflect.Value, _ []string) bool {
+		if !isText(field) {
+			return false
+		}
+
+		for _, regex := range regexes {
+			if regex().MatchString(field.String()) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// matchesNumeric returns handler validating that string or json number matches regex of numbers. Other kinds never match.
+func matchesNumeric(regex func() *regexp.Regexp) validation.RuleHandlerFunc {
+	return func(field reflect.Value, _ []string) bool {
+		return field.Kind() == reflect.String && regex().MatchString(field.String())
+	}
+}
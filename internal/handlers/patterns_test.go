@@ -0,0 +1,101 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+func TestPatternHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule    string
+		valid   interface{}
+		invalid interface{}
+	}{
+		{rule: "alpha", valid: "abc", invalid: "ab1"},
+		{rule: "alphanum", valid: "ab1", invalid: "ab-1"},
+		{rule: "alphanum", valid: "1", invalid: json.Number("1")},
+		{rule: "alphanumunicode", valid: "привет1", invalid: "привет 1"},
+		{rule: "numeric", valid: "-1.5", invalid: "1e3"},
+		{rule: "numeric", valid: json.Number("42"), invalid: json.Number("1e3")},
+		{rule: "hexadecimal", valid: "0xFF", invalid: "0xFG"},
+		{rule: "hexcolor", valid: "#fff", invalid: "fff"},
+		{rule: "rgb", valid: "rgb(255, 0, 128)", invalid: "rgb(256,0,0)"},
+		{rule: "rgba", valid: "rgba(255,0,128,0.5)", invalid: "rgba(255,0,128)"},
+		{rule: "hsl", valid: "hsl(360,100%,50%)", invalid: "hsl(361,100%,50%)"},
+		{rule: "hsla", valid: "hsla(120,50%,50%,0.5)", invalid: "hsla(120,50%,50%)"},
+		{rule: "e164", valid: "+14155552671", invalid: "14155552671"},
+		{rule: "base32", valid: "MZXW6YTBOI======", invalid: "mzxw6ytb"},
+		{rule: "base64", valid: "Zm9vYmFy", invalid: "Zm9vYmF"},
+		{rule: "base64url", valid: "Pz8-", invalid: "Pz8+"},
+		{rule: "base64rawurl", valid: "Pz8", invalid: "Pz8="},
+		{rule: "uuid", valid: "a987fbc9-4bed-3078-cf07-9141ba07c9f3", invalid: "A987FBC9-4BED-3078-CF07-9141BA07C9F3"},
+		{rule: "uuid3", valid: "a987fbc9-4bed-3078-cf07-9141ba07c9f3", invalid: "57b73598-8764-4ad0-a76a-679bb6640eb1"},
+		{rule: "uuid4", valid: "57b73598-8764-4ad0-a76a-679bb6640eb1", invalid: "a987fbc9-4bed-3078-cf07-9141ba07c9f3"},
+		{rule: "uuid5", valid: "987fbc97-4bed-5078-af07-9141ba07c9f3", invalid: "987fbc97-4bed-5078-cf07-9141ba07c9f3"},
+		{rule: "uuid_rfc4122", valid: "A987FBC9-4BED-3078-CF07-9141BA07C9F3", invalid: "A987FBC9-4BED-3078-CF07-9141BA07C9F"},
+		{rule: "uuid3_rfc4122", valid: "A987FBC9-4BED-3078-CF07-9141BA07C9F3", invalid: "A987FBC9-4BED-4078-8F07-9141BA07C9F3"},
+		{rule: "uuid4_rfc4122", valid: "57B73598-8764-4AD0-A76A-679BB6640EB1", invalid: "57B73598-8764-4AD0-C76A-679BB6640EB1"},
+		{rule: "uuid5_rfc4122", valid: "987FBC97-4BED-5078-AF07-9141BA07C9F3", invalid: "987FBC97-4BED-5078-CF07-9141BA07C9F3"},
+		{rule: "ulid", valid: "01ARZ3NDEKTSV4RRFFQ69G5FAV", invalid: "01ARZ3NDEKTSV4RRFFQ69G5FAU"},
+		{rule: "md4", valid: strings.Repeat("a", 32), invalid: strings.Repeat("A", 32)},
+		{rule: "md5", valid: "d41d8cd98f00b204e9800998ecf8427e", invalid: "d41d8cd98f00b204e9800998ecf8427"},
+		{rule: "sha256", valid: strings.Repeat("0f", 32), invalid: strings.Repeat("0f", 31)},
+		{rule: "sha384", valid: strings.Repeat("0f", 48), invalid: strings.Repeat("0f", 47)},
+		{rule: "sha512", valid: strings.Repeat("0f", 64), invalid: strings.Repeat("0g", 64)},
+		{rule: "ripemd128", valid: strings.Repeat("e", 32), invalid: strings.Repeat("e", 40)},
+		{rule: "ripemd160", valid: strings.Repeat("e", 40), invalid: strings.Repeat("e", 32)},
+		{rule: "tiger128", valid: strings.Repeat("1", 32), invalid: strings.Repeat("1", 48)},
+		{rule: "tiger160", valid: strings.Repeat("1", 40), invalid: strings.Repeat("1", 48)},
+		{rule: "tiger192", valid: strings.Repeat("1", 48), invalid: strings.Repeat("1", 40)},
+		{rule: "ascii", valid: "abc\n", invalid: "абв"},
+		{rule: "printascii", valid: "abc~", invalid: "abc\n"},
+		{rule: "multibyte", valid: "abcж", invalid: "abc"},
+		{rule: "datauri", valid: "data:text/plain;base64,SGVsbG8=", invalid: "text/plain;base64,SGVsbG8="},
+		{rule: "latitude", valid: json.Number("-90.0"), invalid: "90.1"},
+		{rule: "longitude", valid: "180", invalid: json.Number("180.1")},
+		{rule: "ssn", valid: "123-45-6789", invalid: "123-45-0000"},
+		{rule: "hostname", valid: "example-host", invalid: "-example"},
+		{rule: "hostname_rfc1123", valid: "1example.com", invalid: "example_.com"},
+		{rule: "fqdn", valid: "example.com", invalid: "example"},
+		{rule: "btc_addr", valid: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", invalid: "0BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{rule: "btc_addr_bech32", valid: "BC1QAR0SRRR7XFKVY5L643LYDNW9RE59GTZZWF5MDQ", invalid: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdQ"},
+		{rule: "eth_addr", valid: "0x" + strings.Repeat("aB", 20), invalid: "0x" + strings.Repeat("aB", 19)},
+		{rule: "url_encoded", valid: "a%20b", invalid: "a%2"},
+		{rule: "html_encoded", valid: "&lt;", invalid: "lt"},
+		{rule: "html", valid: "<b>bold</b>", invalid: "bold"},
+		{rule: "bic", valid: "DEUTDEFF", invalid: "DEUTDEF"},
+		{rule: "semver", valid: "1.2.3-beta.1+build", invalid: "1.2"},
+		{rule: "dns_rfc1035_label", valid: "my-label", invalid: "1label"},
+		{rule: "cve", valid: "CVE-2021-44228", invalid: "CVE-2021-0000"},
+		{rule: "mongodb", valid: "mongodb://localhost:27017", invalid: "http://localhost"},
+		{rule: "cron", valid: "*/5 * * * *", invalid: "every minute"},
+		{rule: "spicedb_id", valid: "user_1", invalid: "user 1"},
+		{rule: "spicedb_permission", valid: "view_doc", invalid: "View"},
+		{rule: "spicedb_type", valid: "org/document", invalid: "Document"},
+		{rule: "alpha", valid: "a", invalid: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			handler, ok := handlers.BuiltInHandlers[tt.rule]
+			if !ok {
+				t.Fatalf("rule %q is not registered", tt.rule)
+			}
+
+			if !handler(reflect.ValueOf(tt.valid), nil) {
+				t.Errorf("%s(%q) = false, want true", tt.rule, tt.valid)
+			}
+
+			if handler(reflect.ValueOf(tt.invalid), nil) {
+				t.Errorf("%s(%q) = true, want false", tt.rule, tt.invalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// maxCachedPatterns bounds count of compiled patterns of regex rules kept in memory.
+const maxCachedPatterns = 256
+
+// patterns caches compiled patterns of regex and not_regex rules, so every pattern is compiled once per tag instead of once per value.
+var patterns = newPatternCache(maxCachedPatterns) //nolint:gochecknoglobals
+
+// patternCache is concurrency-safe cache of compiled patterns. When it is full, the oldest pattern is evicted.
+// Cached patterns are read under shared lock, so concurrent validations do not wait for each other.
+type patternCache struct {
+	mu      sync.RWMutex
+	size    int
+	regexes map[string]*regexp.Regexp
+	order   []string
+}
+
+func newPatternCache(size int) *patternCache {
+	return &patternCache{
+		size:    size,
+		regexes: make(map[string]*regexp.Regexp, size),
+		order:   make([]string, 0, size),
+	}
+}
+
+// compile returns compiled pattern from cache or compiles and caches it. Pattern is compiled without lock.
+func (c *patternCache) compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.RLock()
+	regex, ok := c.regexes[pattern]
+	c.mu.RUnlock()
+
+	if ok {
+		return regex, nil
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Other goroutine may have cached the same pattern meanwhile
+	if cached, ok := c.regexes[pattern]; ok {
+		return cached, nil
+	}
+
+	if len(c.order) >= c.size {
+		delete(c.regexes, c.order[0])
+		c.order = c.order[1:]
+	}
+
+	c.regexes[pattern] = regex
+	c.order = append(c.order, pattern)
+
+	return regex, nil
+}
+
+// IsRegex is the validation function for validating if the current field's value matches RE2 pattern of params.
+// Pattern with "|" or "," must be quoted: "regex:'^(draft|published)$'", quote inside quotes is doubled.
+func IsRegex(field reflect.Value, params []string) bool {
+	regex := asPattern(params)
+
+	return isText(field) && regex.MatchString(field.String())
+}
+
+// IsNotRegex is the validation function for validating if the current field's value is string which does not match pattern of params, see IsRegex.
+func IsNotRegex(field reflect.Value, params []string) bool {
+	regex := asPattern(params)
+
+	return isText(field) && !regex.MatchString(field.String())
+}
+
+// NewNamedPattern returns pattern handler, which validates that value matches pattern registered under name of param, "pattern:sku".
+// Patterns map is read on every call, so patterns registered later are found too.
+func NewNamedPattern(patterns map[string]*regexp.Regexp) validation.RuleHandlerFunc {
+	return func(field reflect.Value, params []string) bool {
+		if len(params) < 1 {
+			panic(fmt.Errorf("%w: pattern expects name", validation.ErrInvalidRuleArgs))
+		}
+
+		regex, ok := patterns[params[0]]
+		if !ok {
+			panic(fmt.Errorf("%w: pattern %q is not registered", validation.ErrInvalidRuleArgs, params[0]))
+		}
+
+		return isText(field) && regex.MatchString(field.String())
+	}
+}
+
+// asPattern returns compiled pattern of params. Unquoted pattern with commas is split to params, so they are joined back.
+// Panics if pattern can't be compiled.
+func asPattern(params []string) *regexp.Regexp {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: regex expects pattern", validation.ErrInvalidRuleArgs))
+	}
+
+	regex, err := patterns.compile(strings.Join(params, ","))
+	if err != nil {
+		panic(fmt.Errorf("%w: %w", validation.ErrInvalidRuleArgs, err))
+	}
+
+	return regex
+}
@@ -0,0 +1,100 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestRegexHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule   string
+		params []string
+		value  interface{}
+		want   bool
+	}{
+		{rule: "regex", params: []string{"^(draft|published)$"}, value: "draft", want: true},
+		{rule: "regex", params: []string{"^(draft|published)$"}, value: "archived", want: false},
+		{rule: "regex", params: []string{"^[a", "b]+$"}, value: "a,b", want: true},
+		{rule: "regex", params: []string{`^\d{3}$`}, value: json.Number("123"), want: false},
+		{rule: "regex", params: []string{`^\d{3}$`}, value: 123, want: false},
+		{rule: "not_regex", params: []string{"(?i)admin"}, value: "john", want: true},
+		{rule: "not_regex", params: []string{"(?i)admin"}, value: "Administrator", want: false},
+		{rule: "not_regex", params: []string{"(?i)admin"}, value: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s:%v(%v) = %v, want %v", tt.rule, tt.params, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegexHandlersInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	patternHandler := handlers.NewNamedPattern(map[string]*regexp.Regexp{"sku": regexp.MustCompile(`^[A-Z]{3}-\d+$`)})
+
+	tests := []struct {
+		name    string
+		handler validation.RuleHandlerFunc
+		params  []string
+	}{
+		{name: "regex without pattern", handler: handlers.IsRegex},
+		{name: "invalid regex", handler: handlers.IsRegex, params: []string{"(a"}},
+		{name: "invalid not_regex", handler: handlers.IsNotRegex, params: []string{"[a"}},
+		{name: "unregistered pattern", handler: patternHandler, params: []string{"ean"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, validation.ErrInvalidRuleArgs) {
+					t.Errorf("%s panic = %v, want %v", tt.name, err, validation.ErrInvalidRuleArgs)
+				}
+			}()
+
+			tt.handler(reflect.ValueOf("ABC-1"), tt.params)
+		})
+	}
+}
+
+func TestRegexHandlersConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var wg sync.WaitGroup
+
+	// More patterns than cache keeps, so patterns are evicted while other goroutines read them
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 400 {
+				pattern := "^" + strconv.Itoa((i+worker*50)%400) + "$"
+				value := strconv.Itoa((i + worker*50) % 400)
+
+				if !handlers.IsRegex(reflect.ValueOf(value), []string{pattern}) {
+					t.Errorf("regex:%s(%s) = false, want true", pattern, value)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -15,6 +15,7 @@ const (
 	alphaUnicodeNumericRegexString   = "^[\\p{L}\\p{N}]+$"
 	numericRegexString               = "^[-+]?[0-9]+(?:\\.[0-9]+)?$"
 	numberRegexString                = "^[0-9]+$"
+	decimalRegexString               = "^[-+]?[0-9]+(?:\\.[0-9]+)?(?:[eE][-+]?[0-9]+)?$"
 	hexadecimalRegexString           = "^(0[xX])?[0-9a-fA-F]+$"
 	hexColorRegexString              = "^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"
 	rgbRegexString                   = "^rgb\\(\\s*(?:(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])|(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%)\\s*\\)$"
@@ -102,6 +103,7 @@ var (
 	alphaUnicodeNumericRegex   = lazyRegexCompile(alphaUnicodeNumericRegexString)
 	numericRegex               = lazyRegexCompile(numericRegexString)
 	numberRegex                = lazyRegexCompile(numberRegexString)
+	decimalRegex               = lazyRegexCompile(decimalRegexString)
 	hexadecimalRegex           = lazyRegexCompile(hexadecimalRegexString)
 	hexColorRegex              = lazyRegexCompile(hexColorRegexString)
 	rgbRegex                   = lazyRegexCompile(rgbRegexString)
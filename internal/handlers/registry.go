@@ -1,35 +1,94 @@
 package handlers
 
 import (
+	"maps"
+
 	"github.com/thumbrise/validrator/internal/validation"
 )
 
-// BuiltInHandlers is the default pack of validations.
-var BuiltInHandlers = map[string]validation.RuleHandlerFunc{
-	"len":          HasLengthOf,
-	"boolean":      IsBoolean,
-	"min":          HasMinOf,
-	"max":          HasMaxOf,
-	"eq":           IsEq,
-	"ne":           IsNe,
-	"lt":           IsLt,
-	"lte":          IsLte,
-	"gt":           IsGt,
-	"gte":          IsGte,
-	"jwt":          IsJWT,
-	"alphaunicode": IsAlphaUnicode,
-	"datetime":     IsDatetime,
-	"number":       IsNumber,
-	"email":        IsEmail,
-	"url":          IsURL,
-	"http_url":     IsHttpURL,
-	"uri":          IsURI,
-	"contains":     Contains,
-	"oneof":        IsOneOf,
+// BuiltInHandlers is the default pack of validations. Rules matching value against pattern are listed in patterns.go,
+// time rules using SystemClock are listed in time.go.
+var BuiltInHandlers = withPatternHandlers(map[string]validation.RuleHandlerFunc{
+	"len":             HasLengthOf,
+	"boolean":         IsBoolean,
+	"min":             HasMinOf,
+	"max":             HasMaxOf,
+	"eq":              IsEq,
+	"ne":              IsNe,
+	"lt":              IsLt,
+	"lte":             IsLte,
+	"gt":              IsGt,
+	"gte":             IsGte,
+	"jwt":             IsJWT,
+	"alphaunicode":    IsAlphaUnicode,
+	"datetime":        IsDatetime,
+	"number":          IsNumber,
+	"email":           IsEmail,
+	"url":             IsURL,
+	"http_url":        IsHttpURL,
+	"uri":             IsURI,
+	"contains":        Contains,
+	"oneof":           IsOneOf,
+	"decimal":         IsDecimal,
+	"multiple_of":     IsMultipleOf,
+	"decimal_min":     HasDecimalMinOf,
+	"decimal_max":     HasDecimalMaxOf,
+	"ip":              IsIP,
+	"ipv4":            IsIPv4,
+	"ipv6":            IsIPv6,
+	"cidr":            IsCIDR,
+	"cidrv4":          IsCIDRv4,
+	"cidrv6":          IsCIDRv6,
+	"mac":             IsMAC,
+	"port":            IsPort,
+	"hostname_port":   IsHostnamePort,
+	"ip_in":           IsIPIn,
+	"public_ip":       IsPublicIP,
+	"private_ip":      IsPrivateIP,
+	"safe_url":        IsSafeURL,
+	"url_host_in":     HasURLHostIn,
+	"url_host_not_in": HasURLHostNotIn,
+	"luhn":            IsLuhn,
+	"credit_card":     IsCreditCard,
+	"iban":            IsIBAN,
+	"isbn10":          IsISBN10,
+	"isbn13":          IsISBN13,
+	"ean8":            IsEAN8,
+	"ean13":           IsEAN13,
+	"issn":            IsISSN,
+	"imei":            IsIMEI,
+	"iso3166_alpha2":  IsISO3166Alpha2,
+	"iso3166_alpha3":  IsISO3166Alpha3,
+	"iso3166_numeric": IsISO3166Numeric,
+	"iso4217":         IsISO4217,
+	"iso639_1":        IsISO639_1,
+	"bcp47":           IsBCP47,
+	"timezone":        IsTimezone,
+	"weekday":         IsWeekday,
+	"weekend":         IsWeekend,
+	"duration":        IsDuration,
+	"duration_min":    HasDurationMinOf,
+	"duration_max":    HasDurationMaxOf,
+	"min_items":       HasMinItems,
+	"max_items":       HasMaxItems,
+	"unique":          IsUnique,
+	"contains_value":  ContainsValue,
+	"subset_of":       IsSubsetOf,
+	"sorted":          IsSorted,
+	"sorted_desc":     IsSortedDesc,
+	"regex":           IsRegex,
+	"not_regex":       IsNotRegex,
 	// "eqfield":  isEqField,
 	// "nefield":  isNeField,
 	// "gtefield": isGteField,
 	// "gtfield":  IsGtField,
 	// "ltefield": isLteField,
 	// "ltfield":  isLtField,
+})
+
+func withPatternHandlers(handlers map[string]validation.RuleHandlerFunc) map[string]validation.RuleHandlerFunc {
+	maps.Copy(handlers, patternHandlers)
+	maps.Copy(handlers, NewTimeHandlers(SystemClock))
+
+	return handlers
 }
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// DefaultResolveTimeout bounds DNS lookup of single safe_url value, when timeout is not set.
+const DefaultResolveTimeout = 5 * time.Second
+
+// defaultSafeURLSchemes are schemes accepted by safe_url without arguments.
+var defaultSafeURLSchemes = []string{"http", "https"}
+
+// Resolver resolves host name to IP addresses. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// IsSafeURL is the validation function for validating if the current field's value is URL which is safe to request from server,
+// see NewSafeURL. Host names are not resolved.
+func IsSafeURL(field reflect.Value, params []string) bool {
+	return NewSafeURL(nil, 0)(field, params)
+}
+
+// NewSafeURL returns safe_url handler, which validates that URL has allowed scheme (params, "http" and "https" by default)
+// and host which is not literal private, loopback, link-local or other non-public IP address, "localhost" or numeric host like "2130706433".
+// When resolver is not nil, every resolved address of host must be public too, unresolvable host is rejected.
+// Lookup taking longer than timeout (DefaultResolveTimeout when timeout is not positive) rejects host too.
+// Notice that host may resolve differently when URL is requested, so requesting client should pin checked addresses.
+func NewSafeURL(resolver Resolver, timeout time.Duration) validation.RuleHandlerFunc {
+	if timeout <= 0 {
+		timeout = DefaultResolveTimeout
+	}
+
+	return func(field reflect.Value, params []string) bool {
+		schemes := defaultSafeURLSchemes
+		if len(params) > 0 {
+			schemes = params
+		}
+
+		parsed, ok := urlOf(field)
+		if !ok || !slices.Contains(schemes, strings.ToLower(parsed.Scheme)) {
+			return false
+		}
+
+		host := hostOf(parsed)
+		if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+			return false
+		}
+
+		if addr, err := netip.ParseAddr(host); err == nil {
+			return isPublicAddr(addr.WithZone(""))
+		}
+
+		if isNumericHost(host) {
+			return false
+		}
+
+		if resolver == nil {
+			return true
+		}
+
+		return isResolvedPublic(resolver, host, timeout)
+	}
+}
+
+// HasURLHostIn is the validation function for validating if host of URL is one of params. Param "*.example.com" matches subdomains of example.com.
+func HasURLHostIn(field reflect.Value, params []string) bool {
+	parsed, ok := urlOf(field)
+
+	return ok && matchesHost(hostOf(parsed), params)
+}
+
+// HasURLHostNotIn is the validation function for validating if host of URL is none of params, see HasURLHostIn.
+func HasURLHostNotIn(field reflect.Value, params []string) bool {
+	parsed, ok := urlOf(field)
+
+	return ok && hostOf(parsed) != "" && !matchesHost(hostOf(parsed), params)
+}
+
+func urlOf(field reflect.Value) (*url.URL, bool) {
+	if !isText(field) {
+		return nil, false
+	}
+
+	parsed, err := url.Parse(field.String())
+
+	return parsed, err == nil
+}
+
+// hostOf returns lowercase host of URL without port, brackets and trailing dot.
+func hostOf(parsed *url.URL) string {
+	return strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+}
+
+func matchesHost(host string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if host == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNumericHost detects IPv4 address in forms which are not parsed by netip, but are accepted by some resolvers: "127.1", "0x7f.0.0.1".
+// Last label of real host name is never numeric.
+func isNumericHost(host string) bool {
+	labels := strings.Split(host, ".")
+	last := labels[len(labels)-1]
+
+	if strings.HasPrefix(last, "0x") {
+		return true
+	}
+
+	return strings.Trim(last, "0123456789") == ""
+}
+
+func isResolvedPublic(resolver Resolver, host string, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	addrs, err := resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return false
+	}
+
+	for _, addr := range addrs {
+		if !isPublicAddr(addr) {
+			return false
+		}
+	}
+
+	return true
+}
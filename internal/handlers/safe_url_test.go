@@ -0,0 +1,102 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+var errHostNotFound = errors.New("host not found")
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	texts, ok := r[host]
+	if !ok {
+		return nil, errHostNotFound
+	}
+
+	addrs := make([]netip.Addr, 0, len(texts))
+	for _, text := range texts {
+		addrs = append(addrs, netip.MustParseAddr(text))
+	}
+
+	return addrs, nil
+}
+
+// blockingResolver answers only when lookup is canceled.
+type blockingResolver struct{}
+
+func (blockingResolver) LookupNetIP(ctx context.Context, _, _ string) ([]netip.Addr, error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err() //nolint:wrapcheck
+}
+
+func TestSafeURL(t *testing.T) {
+	t.Parallel()
+
+	resolver := fakeResolver{
+		"example.com":       {"93.184.215.14", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"},
+		"internal.example":  {"10.0.0.5"},
+		"rebind.example":    {"93.184.215.14", "127.0.0.1"},
+		"metadata.internal": {"169.254.169.254"},
+		"nat64.example":     {"64:ff9b::a9fe:a9fe"},
+	}
+
+	tests := []struct {
+		name    string
+		handler validation.RuleHandlerFunc
+		value   interface{}
+		params  []string
+		want    bool
+	}{
+		{name: "public host", handler: handlers.IsSafeURL, value: "https://example.com/path", want: true},
+		{name: "public ip", handler: handlers.IsSafeURL, value: "http://8.8.8.8:8080/", want: true},
+		{name: "metadata ip", handler: handlers.IsSafeURL, value: "http://169.254.169.254/latest/meta-data", want: false},
+		{name: "loopback ipv6", handler: handlers.IsSafeURL, value: "http://[::1]/", want: false},
+		{name: "mapped loopback", handler: handlers.IsSafeURL, value: "http://[::ffff:127.0.0.1]/", want: false},
+		{name: "ipv4-compatible metadata ip", handler: handlers.IsSafeURL, value: "http://[::a9fe:a9fe]/", want: false},
+		{name: "nat64 metadata ip", handler: handlers.IsSafeURL, value: "http://[64:ff9b::a9fe:a9fe]/", want: false},
+		{name: "local-use nat64 ip", handler: handlers.IsSafeURL, value: "http://[64:ff9b:1::a00:1]/", want: false},
+		{name: "6to4 metadata ip", handler: handlers.IsSafeURL, value: "http://[2002:a9fe:a9fe::]/", want: false},
+		{name: "private ip", handler: handlers.IsSafeURL, value: "http://192.168.1.1/", want: false},
+		{name: "decimal ip", handler: handlers.IsSafeURL, value: "http://2130706433/", want: false},
+		{name: "short ip", handler: handlers.IsSafeURL, value: "http://127.1/", want: false},
+		{name: "hex ip", handler: handlers.IsSafeURL, value: "http://0x7f.0.0.1/", want: false},
+		{name: "localhost", handler: handlers.IsSafeURL, value: "http://LOCALHOST./", want: false},
+		{name: "file scheme", handler: handlers.IsSafeURL, value: "file:/etc/passwd", want: false},
+		{name: "scheme not allowed by args", handler: handlers.IsSafeURL, value: "http://example.com", params: []string{"https"}, want: false},
+		{name: "scheme allowed by args", handler: handlers.IsSafeURL, value: "FTP://example.com", params: []string{"ftp"}, want: true},
+		{name: "no host", handler: handlers.IsSafeURL, value: "https:///path", want: false},
+		{name: "not string", handler: handlers.IsSafeURL, value: 1, want: false},
+		{name: "json number", handler: handlers.IsSafeURL, value: json.Number("1"), want: false},
+		{name: "resolved public", handler: handlers.NewSafeURL(resolver, 0), value: "https://example.com", want: true},
+		{name: "resolved private", handler: handlers.NewSafeURL(resolver, 0), value: "https://internal.example", want: false},
+		{name: "any resolved loopback", handler: handlers.NewSafeURL(resolver, 0), value: "https://rebind.example", want: false},
+		{name: "resolved link-local", handler: handlers.NewSafeURL(resolver, 0), value: "http://metadata.internal", want: false},
+		{name: "resolved nat64", handler: handlers.NewSafeURL(resolver, 0), value: "http://nat64.example", want: false},
+		{name: "unresolvable", handler: handlers.NewSafeURL(resolver, 0), value: "https://unknown.example", want: false},
+		{name: "resolve timeout", handler: handlers.NewSafeURL(blockingResolver{}, time.Millisecond), value: "https://example.com", want: false},
+		{name: "host in", handler: handlers.HasURLHostIn, value: "https://api.example.com/v1", params: []string{"example.org", "*.example.com"}, want: true},
+		{name: "host in wildcard excludes apex", handler: handlers.HasURLHostIn, value: "https://example.com", params: []string{"*.example.com"}, want: false},
+		{name: "host not in", handler: handlers.HasURLHostNotIn, value: "https://example.com", params: []string{"evil.com"}, want: true},
+		{name: "host denied", handler: handlers.HasURLHostNotIn, value: "https://Evil.com.:443", params: []string{"evil.com"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.handler(reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("handler(%v, %v) = %v, want %v", tt.value, tt.params, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// nowParam is time argument meaning current time of Clock. It may be shifted by duration: "now+24h", "now-90m".
+const nowParam = "now"
+
+// layouts are shorthand names of time layouts accepted by datetime rule, "datetime:RFC3339".
+var layouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// valueLayouts are layouts of time values and time arguments, tried in order. Values without offset are in UTC.
+var valueLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// Clock provides current time to time rules.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+// Now godoc.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is Clock of real time.
+var SystemClock Clock = systemClock{} //nolint:gochecknoglobals
+
+// ReferenceRules are names of rules of NewTimeHandlers, which arguments may reference other fields, see validation.ReferencePrefix.
+var ReferenceRules = map[string]bool{ //nolint:gochecknoglobals
+	"before":          true,
+	"after":           true,
+	"before_or_equal": true,
+	"after_or_equal":  true,
+	"between":         true,
+}
+
+// NewTimeHandlers returns time rules which use clock for "now" arguments:
+// before, after, before_or_equal, after_or_equal and between ("between:now-24h,now").
+// It also returns lt, lte, gt, gte, min and max, which compare time.Time values with clock and other values as IsLt and others do.
+// Time arguments are "now" with optional duration offset, RFC 3339 time ("2025-01-01T10:00:00Z"), "2006-01-02 15:04:05" or date ("2025-01-01").
+// In tags they may also reference other field, see ReferenceRules.
+// Compared values are time.Time or strings in the same formats, other values do not pass.
+func NewTimeHandlers(clock Clock) map[string]validation.RuleHandlerFunc {
+	return map[string]validation.RuleHandlerFunc{
+		"before": func(field reflect.Value, params []string) bool {
+			return compareTime(field, params, clock, func(value, limit time.Time) bool { return value.Before(limit) })
+		},
+		"after": func(field reflect.Value, params []string) bool {
+			return compareTime(field, params, clock, func(value, limit time.Time) bool { return value.After(limit) })
+		},
+		"before_or_equal": func(field reflect.Value, params []string) bool {
+			return compareTime(field, params, clock, func(value, limit time.Time) bool { return !value.After(limit) })
+		},
+		"after_or_equal": func(field reflect.Value, params []string) bool {
+			return compareTime(field, params, clock, func(value, limit time.Time) bool { return !value.Before(limit) })
+		},
+		"lt":  withClock(IsLt, clock, func(value, limit time.Time) bool { return value.Before(limit) }),
+		"lte": withClock(IsLte, clock, func(value, limit time.Time) bool { return !value.After(limit) }),
+		"gt":  withClock(IsGt, clock, func(value, limit time.Time) bool { return value.After(limit) }),
+		"gte": withClock(IsGte, clock, func(value, limit time.Time) bool { return !value.Before(limit) }),
+		"min": withClock(HasMinOf, clock, func(value, limit time.Time) bool { return !value.Before(limit) }),
+		"max": withClock(HasMaxOf, clock, func(value, limit time.Time) bool { return !value.After(limit) }),
+		"between": func(field reflect.Value, params []string) bool {
+			if len(params) < 2 { //nolint:mnd
+				panic(fmt.Errorf("%w: between expects 2 arguments", validation.ErrInvalidRuleArgs))
+			}
+
+			from := asTime(params[0], clock)
+			to := asTime(params[1], clock)
+
+			value, ok := timeOf(field)
+
+			return ok && !value.Before(from) && !value.After(to)
+		},
+	}
+}
+
+// IsWeekday is the validation function for validating if the current field's value is time from Monday to Friday in its own offset.
+func IsWeekday(field reflect.Value, _ []string) bool {
+	value, ok := timeOf(field)
+
+	return ok && value.Weekday() != time.Saturday && value.Weekday() != time.Sunday
+}
+
+// IsWeekend is the validation function for validating if the current field's value is time on Saturday or Sunday in its own offset.
+func IsWeekend(field reflect.Value, _ []string) bool {
+	value, ok := timeOf(field)
+
+	return ok && (value.Weekday() == time.Saturday || value.Weekday() == time.Sunday)
+}
+
+// withClock returns handler which compares time.Time values with time argument of clock and passes other values to handler.
+func withClock(handler validation.RuleHandlerFunc, clock Clock, compare func(value, limit time.Time) bool) validation.RuleHandlerFunc {
+	return func(field reflect.Value, params []string) bool {
+		if len(params) < 1 || field.Kind() != reflect.Struct || !field.Type().ConvertibleTo(timeType) {
+			return handler(field, params)
+		}
+
+		return compareTime(field, params, clock, compare)
+	}
+}
+
+func compareTime(field reflect.Value, params []string, clock Clock, compare func(value, limit time.Time) bool) bool {
+	if len(params) < 1 {
+		panic(fmt.Errorf("%w: time rule expects 1 argument", validation.ErrInvalidRuleArgs))
+	}
+
+	limit := asTime(params[0], clock)
+
+	value, ok := timeOf(field)
+
+	return ok && compare(value, limit)
+}
+
+// asTime returns the parameter as time
+// or panics if it can't convert.
+func asTime(param string, clock Clock) time.Time {
+	if offset, ok := strings.CutPrefix(param, nowParam); ok {
+		if offset == "" {
+			return clock.Now()
+		}
+
+		duration, err := time.ParseDuration(offset)
+		panicIf(err)
+
+		return clock.Now().Add(duration)
+	}
+
+	value, ok := parseTime(param)
+	if !ok {
+		panic(fmt.Errorf("%w: %q is not time", validation.ErrInvalidRuleArgs, param))
+	}
+
+	return value
+}
+
+// asLayout returns time layout by shorthand name or the parameter itself.
+func asLayout(param string) string {
+	if layout, ok := layouts[param]; ok {
+		return layout
+	}
+
+	return param
+}
+
+func timeOf(field reflect.Value) (time.Time, bool) {
+	if field.Kind() == reflect.Struct && field.Type().ConvertibleTo(timeType) {
+		value, ok := field.Convert(timeType).Interface().(time.Time)
+
+		return value, ok
+	}
+
+	if field.Kind() != reflect.String || isJSONNumber(field) {
+		return time.Time{}, false
+	}
+
+	return parseTime(field.String())
+}
+
+func parseTime(text string) (time.Time, bool) {
+	for _, layout := range valueLayouts {
+		if value, err := time.Parse(layout, text); err == nil {
+			return value, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,153 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestTimeHandlers(t *testing.T) {
+	t.Parallel()
+
+	timeHandlers := handlers.NewTimeHandlers(fixedClock(time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)))
+
+	tests := []struct {
+		rule   string
+		params []string
+		value  interface{}
+		want   bool
+	}{
+		{rule: "before", params: []string{"2025-01-01"}, value: "2024-12-31", want: true},
+		{rule: "before", params: []string{"2025-01-01"}, value: "2025-01-01", want: false},
+		{rule: "before", params: []string{"2025-01-01"}, value: "2024-12-31T23:59:59+00:00", want: true},
+		{rule: "before", params: []string{"2025-01-01"}, value: "2025-01-01T00:30:00+01:00", want: true},
+		{rule: "before", params: []string{"now"}, value: "2025-06-15 11:59:59", want: true},
+		{rule: "before", params: []string{"2025-01-01"}, value: "yesterday", want: false},
+		{rule: "before", params: []string{"2025-01-01"}, value: json.Number("20241231"), want: false},
+		{rule: "after", params: []string{"now+24h"}, value: "2025-06-16T12:00:01Z", want: true},
+		{rule: "after", params: []string{"now+24h"}, value: "2025-06-16T12:00:00Z", want: false},
+		{rule: "after", params: []string{"now-1h30m"}, value: time.Date(2025, 6, 15, 11, 0, 0, 0, time.UTC), want: true},
+		{rule: "before_or_equal", params: []string{"2025-06-15T12:00:00Z"}, value: "2025-06-15T12:00:00Z", want: true},
+		{rule: "before_or_equal", params: []string{"2025-06-15T12:00:00Z"}, value: "2025-06-15T12:00:00.5Z", want: false},
+		{rule: "after_or_equal", params: []string{"now"}, value: "2025-06-15T12:00:00Z", want: true},
+		{rule: "after_or_equal", params: []string{"now"}, value: "2025-06-15", want: false},
+		{rule: "between", params: []string{"now-24h", "now"}, value: "2025-06-14T12:00:00Z", want: true},
+		{rule: "between", params: []string{"now-24h", "now"}, value: "2025-06-15T12:00:01Z", want: false},
+		{rule: "between", params: []string{"2025-01-01", "2025-12-31"}, value: "2025-07-01", want: true},
+		{rule: "lt", params: []string{"now"}, value: time.Date(2025, 6, 15, 11, 0, 0, 0, time.UTC), want: true},
+		{rule: "lt", params: []string{"now-2h"}, value: time.Date(2025, 6, 15, 11, 0, 0, 0, time.UTC), want: false},
+		{rule: "gte", params: []string{"now"}, value: time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC), want: true},
+		{rule: "gt", params: []string{"now+1h"}, value: time.Date(2025, 6, 15, 12, 30, 0, 0, time.UTC), want: false},
+		{rule: "max", params: []string{"now"}, value: time.Date(2025, 6, 15, 12, 0, 1, 0, time.UTC), want: false},
+		{rule: "min", params: []string{"now-24h"}, value: time.Date(2025, 6, 14, 12, 0, 0, 0, time.UTC), want: true},
+		{rule: "lte", params: []string{"3"}, value: "abc", want: true},
+		{rule: "max", params: []string{"10"}, value: json.Number("11"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := timeHandlers[tt.rule](reflect.ValueOf(tt.value), tt.params); got != tt.want {
+				t.Errorf("%s:%v(%v) = %v, want %v", tt.rule, tt.params, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeHandlersInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule   string
+		params []string
+	}{
+		{rule: "before", params: []string{"2025-13-01"}},
+		{rule: "after", params: []string{"now+1d"}},
+		{rule: "between", params: []string{"now"}},
+		{rule: "lt", params: []string{"tomorrow"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, validation.ErrInvalidRuleArgs) {
+					t.Errorf("%s:%v panic = %v, want %v", tt.rule, tt.params, err, validation.ErrInvalidRuleArgs)
+				}
+			}()
+
+			handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(time.Now()), tt.params)
+		})
+	}
+}
+
+func TestTimeComparisonHandlers(t *testing.T) {
+	t.Parallel()
+
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule   string
+		params []string
+		want   bool
+	}{
+		{rule: "lt", params: []string{"2021-01-01"}, want: true},
+		{rule: "lt", params: []string{"2019-01-01"}, want: false},
+		{rule: "gt", params: []string{"2019-01-01"}, want: true},
+		{rule: "gt", params: []string{"now"}, want: false},
+		{rule: "lte", params: []string{"2020-01-01"}, want: true},
+		{rule: "gte", params: []string{"2020-01-01T00:00:00Z"}, want: true},
+		{rule: "gte", params: []string{"now-24h"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(past), tt.params); got != tt.want {
+				t.Errorf("%s:%v = %v, want %v", tt.rule, tt.params, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule  string
+		value interface{}
+		want  bool
+	}{
+		{rule: "weekday", value: "2025-06-13", want: true},
+		{rule: "weekday", value: "2025-06-14", want: false},
+		{rule: "weekday", value: "2025-06-13T23:30:00-02:00", want: true},
+		{rule: "weekday", value: "Friday", want: false},
+		{rule: "weekend", value: "2025-06-15", want: true},
+		{rule: "weekend", value: time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC), want: false},
+		{rule: "datetime", value: "2025-06-15T12:00:00Z", want: true},
+		{rule: "datetime", value: "2025-06-15", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			params := []string{"RFC3339"}
+
+			if got := handlers.BuiltInHandlers[tt.rule](reflect.ValueOf(tt.value), params); got != tt.want {
+				t.Errorf("%s(%v) = %v, want %v", tt.rule, tt.value, got, tt.want)
+			}
+		})
+	}
+}
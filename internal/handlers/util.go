@@ -1,16 +1,20 @@
 package handlers
 
 import (
+	"fmt"
 	"reflect"
 	"strconv"
 	"time"
+
+	"github.com/thumbrise/validrator/internal/validation"
 )
 
 var timeType = reflect.TypeOf(time.Time{})
 
+// panicIf panics with error wrapping validation.ErrInvalidRuleArgs, so validation reports it as misconfigured rule.
 func panicIf(err error) {
 	if err != nil {
-		panic(err.Error())
+		panic(fmt.Errorf("%w: %w", validation.ErrInvalidRuleArgs, err))
 	}
 }
 
@@ -23,11 +27,11 @@ func asInt(param string) int64 {
 	return i
 }
 
-// asIntFromTimeDuration parses param as time.Duration and returns it as int64
+// asIntFromTimeDuration parses param as Go or ISO 8601 duration and returns it as int64
 // or panics on error.
 func asIntFromTimeDuration(param string) int64 {
-	dur, err := time.ParseDuration(param)
-	if err != nil {
+	dur, ok := ParseDuration(param)
+	if !ok {
 		// attempt parsing as an integer assuming nanosecond precision
 		return asInt(param)
 	}
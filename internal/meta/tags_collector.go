@@ -12,7 +12,9 @@ import (
 const (
 	privateFieldVal   = "-"
 	iterativePrefix   = "[]"
-	tagPartsSeparator = "|"
+	tagPartsSeparator = '|'
+	blankFieldName    = "_"
+	jsonTagKey        = "json"
 )
 
 var errHierarchyFinished = errors.New("hierarchy finished")
@@ -28,63 +30,125 @@ func NewTagsCollector(tagKey string) *TagsCollector {
 }
 
 // Extract returns flat map of founded tags with dot and star notation (field.nestedField: someTag, sliceField.*.someType: someAnotherTag).
+// Star stands for array index as well as map key.
 func (t *TagsCollector) Extract(structure any) map[string][]string {
 	return t.traverseHierarchy(structure)
 }
 
+// Keys returns all field keys of structure in declaration order with dot and star notation.
+// Fields without tags are included too, so the result describes the whole document order.
+func (t *TagsCollector) Keys(structure any) []string {
+	return collectTraverseTree(structure).order
+}
+
+// Names returns names of fields in json document as encoding/json decodes them, by key of their structure.
+// Names of root structure are under empty key, fields of embedded structures are promoted to enclosing one.
+// Keys are compared with names case-insensitively, like encoding/json does.
+func (t *TagsCollector) Names(structure any) map[string][]string {
+	return collectTraverseTree(structure).names
+}
+
+// Types returns types of all field keys of structure with dot and star notation. Pointers are dereferenced.
+// Type of structure itself is returned under empty key.
+func (t *TagsCollector) Types(structure any) map[string]reflect.Type {
+	return collectTraverseTree(structure).types
+}
+
+// traverseTree is flat projection of structure hierarchy which remembers declaration order.
+type traverseTree struct {
+	order  []string
+	fields map[string]reflect.StructField
+	types  map[string]reflect.Type
+	// blanks are "_" fields by key of enclosing structure, their tags describe the structure itself.
+	blanks map[string][]reflect.StructField
+	// names are json names of fields by key of enclosing structure.
+	names map[string][]string
+}
+
+// promotedStruct is embedded structure without json name, its fields belong to enclosing structure.
+type promotedStruct struct {
+	typ reflect.Type
+}
+
+func (tree *traverseTree) add(key string, field reflect.StructField) {
+	key = strings.TrimSuffix(key, ".")
+
+	if _, ok := tree.fields[key]; !ok {
+		tree.order = append(tree.order, key)
+	}
+
+	tree.fields[key] = field
+}
+
+func collectTraverseTree(structure any) *traverseTree {
+	tree := &traverseTree{
+		fields: make(map[string]reflect.StructField),
+		types:  make(map[string]reflect.Type),
+		blanks: make(map[string][]reflect.StructField),
+		names:  make(map[string][]string),
+	}
+	typesChain := make(map[string]bool)
+
+	_ = computeTraverseTree(structure, tree, "", typesChain)
+
+	return tree
+}
+
 func (t *TagsCollector) traverseHierarchy(structure any) map[string][]string {
 	result := make(map[string][]string)
 
-	toTraverseRaw := make(map[string]reflect.StructField)
-	typesChain := make(map[string]bool)
+	tree := collectTraverseTree(structure)
 
-	_ = computeTraverseTree(structure, toTraverseRaw, "", typesChain)
+	for key, field := range tree.fields {
+		t.appendTagParts(result, key, field)
+	}
 
-	toTraverse := make(map[string]reflect.StructField)
+	for key, fields := range tree.blanks {
+		for _, field := range fields {
+			t.appendTagParts(result, key, field)
+		}
+	}
 
-	for key, value := range toTraverseRaw {
-		keyWithoutDot := strings.TrimSuffix(key, ".")
-		toTraverse[keyWithoutDot] = value
+	return result
+}
+
+func (t *TagsCollector) appendTagParts(result map[string][]string, key string, field reflect.StructField) {
+	rawTag := field.Tag.Get(t.tagKey)
+
+	tagParts := strings2.SplitUnquoted(rawTag, tagPartsSeparator)
+	if len(tagParts) == 0 {
+		return
 	}
 
-	for key, field := range toTraverse {
-		rawTag := field.Tag.Get(t.tagKey)
+	for _, tagPart := range tagParts {
+		partKey := key
 
-		tagParts := strings.Split(rawTag, tagPartsSeparator)
-		if len(tagParts) == 0 {
+		if tagPart == privateFieldVal {
 			continue
 		}
 
-		for _, tagPart := range tagParts {
-			if tagPart == privateFieldVal {
-				continue
-			}
+		tagPart = strings.TrimSpace(tagPart)
+		if tagPart == "" {
+			continue
+		}
 
-			tagPart = strings.TrimSpace(tagPart)
-			if tagPart == "" {
+		// handle iterative tag
+		if strings.HasPrefix(tagPart, iterativePrefix) {
+			realTag := strings.TrimPrefix(tagPart, iterativePrefix)
+			if realTag == "" {
 				continue
 			}
 
-			// handle iterative tag
-			if strings.HasPrefix(tagPart, iterativePrefix) {
-				realTag := strings.TrimPrefix(tagPart, iterativePrefix)
-				if realTag == "" {
-					continue
-				}
-
-				// iterative tag applying to underlying values, so rewrite key with ".*" notation
-				tagPart = realTag
-				key += ".*"
-			}
-
-			result[key] = append(result[key], tagPart)
+			// iterative tag applying to underlying values, so rewrite key of this part with ".*" notation
+			tagPart = realTag
+			partKey += ".*"
 		}
-	}
 
-	return result
+		result[partKey] = append(result[partKey], tagPart)
+	}
 }
 
-func computeTraverseTree(unit interface{}, output map[string]reflect.StructField, hierarchyKeyPrefix string, typesChain map[string]bool) error { //nolint: cyclop // TODO: refactor
+func computeTraverseTree(unit interface{}, output *traverseTree, hierarchyKeyPrefix string, typesChain map[string]bool) error { //nolint: cyclop // TODO: refactor
 	var typ reflect.Type
 
 	var fieldKey string
@@ -93,13 +157,19 @@ func computeTraverseTree(unit interface{}, output map[string]reflect.StructField
 
 	var outputKey string
 
+	promoted := false
+
 	switch val := unit.(type) {
 	case reflect.StructField:
 		outputValue = val
-		fieldKey = strings2.ToCamel(outputValue.Name)
+		name, _ := jsonName(outputValue)
+		fieldKey = strings2.ToCamel(name)
 		typ = outputValue.Type
 	case reflect.Type:
 		typ = val
+	case promotedStruct:
+		typ = val.typ
+		promoted = true
 
 	default:
 		typ = reflect.TypeOf(val)
@@ -134,9 +204,30 @@ func computeTraverseTree(unit interface{}, output map[string]reflect.StructField
 
 		for i := range typ.NumField() {
 			field := typ.Field(i)
-			nextUnits = append(nextUnits, field)
+
+			structKey := strings.TrimSuffix(outputKey, ".")
+
+			// Blank field has no value, its tag describes enclosing structure
+			if field.Name == blankFieldName {
+				output.blanks[structKey] = append(output.blanks[structKey], field)
+
+				continue
+			}
+
+			name, ok := jsonName(field)
+
+			switch {
+			case !ok:
+				continue
+			case name == "":
+				nextUnits = append(nextUnits, promotedStruct{typ: field.Type})
+			default:
+				output.names[structKey] = append(output.names[structKey], name)
+				nextUnits = append(nextUnits, field)
+			}
 		}
-	case reflect.Slice:
+	case reflect.Slice, reflect.Map:
+		// Array indexes and map keys are data, so both are "*" in keys
 		if outputKey != "" {
 			nextPrefix = outputKey + ".*."
 		}
@@ -145,8 +236,13 @@ func computeTraverseTree(unit interface{}, output map[string]reflect.StructField
 	default:
 	}
 
-	if outputKey != "" {
-		output[outputKey] = outputValue
+	// Promoted structure has no key, outputKey is key of enclosing structure
+	if !promoted {
+		output.types[strings.TrimSuffix(outputKey, ".")] = typ
+
+		if outputKey != "" {
+			output.add(outputKey, outputValue)
+		}
 	}
 
 	for _, nextUnit := range nextUnits {
@@ -156,6 +252,36 @@ func computeTraverseTree(unit interface{}, output map[string]reflect.StructField
 	return errHierarchyFinished
 }
 
+// jsonName returns name of field in json document as encoding/json decodes it. False is returned for ignored fields,
+// like `json:"-"` and unexported ones. Embedded structure without name in tag has empty name, its fields are promoted.
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get(jsonTagKey)
+	if tag == privateFieldVal {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+
+	typ := field.Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if field.Anonymous && name == "" && typ.Kind() == reflect.Struct {
+		return "", true
+	}
+
+	if !field.IsExported() {
+		return "", false
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, true
+}
+
 func generateStringType(typ reflect.Type) string {
 	if typ.PkgPath() == "" || typ.Name() == "" {
 		return ""
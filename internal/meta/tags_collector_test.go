@@ -440,12 +440,17 @@ func TestExtractIterativeSlice(t *testing.T) {
 	}
 
 	type testStruct struct {
-		SliceWithIterativeRule []int `validate:"equals 1|[]equals 1"`
+		SliceWithIterativeRule []int    `validate:"equals 1|[]equals 1"`
+		ElementsThenCollection []string `validate:"[]alpha|unique|max_items:1"`
+		IterativeWarning       []int    `validate:"[]min:10|[]warn:max:0"`
 	}
 
 	expected := map[string][]string{
 		"sliceWithIterativeRule":   {"equals 1"},
 		"sliceWithIterativeRule.*": {"equals 1"},
+		"elementsThenCollection":   {"unique", "max_items:1"},
+		"elementsThenCollection.*": {"alpha"},
+		"iterativeWarning.*":       {"min:10", "warn:max:0"},
 	}
 
 	tests := []struct {
@@ -481,3 +486,229 @@ func TestExtractIterativeSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	type innerStruct struct {
+		NestedFieldB int `validate:"nested_field_b"`
+		NestedFieldA int `validate:"nested_field_a"`
+	}
+
+	type testStruct struct {
+		Zeta         string `validate:"zeta"`
+		Alpha        string
+		NestedStruct innerStruct `validate:"nested_struct"`
+		Slice        []struct {
+			Second bool `validate:"second"`
+			First  bool `validate:"first"`
+		} `validate:"slice"`
+		Ints []int `validate:"[]ints"`
+	}
+
+	expected := []string{
+		"zeta",
+		"alpha",
+		"nestedStruct",
+		"nestedStruct.nestedFieldB",
+		"nestedStruct.nestedFieldA",
+		"slice",
+		"slice.*",
+		"slice.*.second",
+		"slice.*.first",
+		"ints",
+		"ints.*",
+	}
+
+	tests := []struct {
+		name      string
+		structure any
+		want      []string
+	}{
+		{
+			name:      "should return keys in declaration order for value struct",
+			structure: testStruct{},
+			want:      expected,
+		},
+		{
+			name:      "should return keys in declaration order for pointer struct",
+			structure: &testStruct{},
+			want:      expected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			collector := meta.NewTagsCollector(tagKey)
+			if got := collector.Keys(tt.structure); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wrong result\nexpected:\n%+v\nactual:\n%+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExtractBlankField(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		_      struct{} `validate:"strict"`
+		Name   string   `validate:"name"`
+		Nested struct {
+			_     struct{} `validate:"nested_strict"`
+			Inner int      `validate:"inner"`
+		} `validate:"nested"`
+		Items []struct {
+			_ struct{} `validate:"item_strict"`
+		}
+	}
+
+	expected := map[string][]string{
+		"":             {"strict"},
+		"name":         {"name"},
+		"nested":       {"nested", "nested_strict"},
+		"nested.inner": {"inner"},
+		"items.*":      {"item_strict"},
+	}
+
+	collector := meta.NewTagsCollector(tagKey)
+
+	got := collector.Extract(testStruct{})
+	if diff := testutil.DiffAsJSON(expected, got); diff != "" {
+		t.Errorf("Wrong result (-expected +actual):\n%s", diff)
+	}
+
+	wantKeys := []string{"name", "nested", "nested.inner", "items", "items.*"}
+	if gotKeys := collector.Keys(testStruct{}); !reflect.DeepEqual(gotKeys, wantKeys) {
+		t.Errorf("Wrong keys\nexpected:\n%+v\nactual:\n%+v", wantKeys, gotKeys)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	t.Parallel()
+
+	type innerStruct struct {
+		Value float64
+	}
+
+	type testStruct struct {
+		Name   string
+		Nested *innerStruct
+		Items  []innerStruct
+		Any    interface{}
+	}
+
+	expected := map[string]reflect.Type{
+		"":              reflect.TypeOf(testStruct{}),
+		"name":          reflect.TypeOf(""),
+		"nested":        reflect.TypeOf(innerStruct{}),
+		"nested.value":  reflect.TypeOf(float64(0)),
+		"items":         reflect.TypeOf([]innerStruct{}),
+		"items.*":       reflect.TypeOf(innerStruct{}),
+		"items.*.value": reflect.TypeOf(float64(0)),
+		"any":           reflect.TypeOf((*interface{})(nil)).Elem(),
+	}
+
+	got := meta.NewTagsCollector(tagKey).Types(&testStruct{})
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Wrong result\nexpected:\n%+v\nactual:\n%+v", expected, got)
+	}
+}
+
+type embeddedStruct struct {
+	ID int `validate:"required"`
+}
+
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	type innerStruct struct {
+		Value float64 `json:"value,omitempty"`
+	}
+
+	type testStruct struct {
+		embeddedStruct
+		FullName string        `json:"full_name" validate:"required"`
+		Secret   string        `json:"-" validate:"required"`
+		Items    []innerStruct `json:"items"`
+		hidden   string        //nolint:unused
+	}
+
+	expectedNames := map[string][]string{
+		"":        {"full_name", "items", "ID"},
+		"items.*": {"value"},
+	}
+
+	collector := meta.NewTagsCollector(tagKey)
+
+	if got := collector.Names(&testStruct{}); !reflect.DeepEqual(got, expectedNames) {
+		t.Errorf("Wrong names\nexpected:\n%+v\nactual:\n%+v", expectedNames, got)
+	}
+
+	expectedRules := map[string][]string{
+		"id":       {"required"},
+		"fullName": {"required"},
+	}
+
+	if got := collector.Extract(&testStruct{}); !reflect.DeepEqual(got, expectedRules) {
+		t.Errorf("Wrong rules\nexpected:\n%+v\nactual:\n%+v", expectedRules, got)
+	}
+}
+
+func TestExtractMap(t *testing.T) {
+	t.Parallel()
+
+	type addressStruct struct {
+		City string `validate:"required"`
+	}
+
+	type testStruct struct {
+		Addresses map[string]addressStruct `validate:"keys:uuid|[]required"`
+		Labels    map[string]string        `validate:"[]max:10"`
+	}
+
+	expectedRules := map[string][]string{
+		"addresses":        {"keys:uuid"},
+		"addresses.*":      {"required"},
+		"addresses.*.city": {"required"},
+		"labels.*":         {"max:10"},
+	}
+
+	collector := meta.NewTagsCollector(tagKey)
+	if got := collector.Extract(&testStruct{}); !reflect.DeepEqual(got, expectedRules) {
+		t.Errorf("Wrong result\nexpected:\n%+v\nactual:\n%+v", expectedRules, got)
+	}
+
+	expectedTypes := map[string]reflect.Type{
+		"":                 reflect.TypeOf(testStruct{}),
+		"addresses":        reflect.TypeOf(map[string]addressStruct{}),
+		"addresses.*":      reflect.TypeOf(addressStruct{}),
+		"addresses.*.city": reflect.TypeOf(""),
+		"labels":           reflect.TypeOf(map[string]string{}),
+		"labels.*":         reflect.TypeOf(""),
+	}
+
+	if got := collector.Types(&testStruct{}); !reflect.DeepEqual(got, expectedTypes) {
+		t.Errorf("Wrong result\nexpected:\n%+v\nactual:\n%+v", expectedTypes, got)
+	}
+}
+
+func TestExtractQuotedArgs(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Status string `validate:"required|regex:'^(draft|published)$'|not_regex:'a|b'"`
+		Answer string `validate:"oneof:don't,do|contains:'|required"`
+		Quote  string `validate:"regex:'it''s|fine'|max:10"`
+	}
+
+	expected := map[string][]string{
+		"status": {"required", "regex:'^(draft|published)$'", "not_regex:'a|b'"},
+		"answer": {"oneof:don't,do", "contains:'", "required"},
+		"quote":  {"regex:'it''s|fine'", "max:10"},
+	}
+
+	if got := meta.NewTagsCollector(tagKey).Extract(&testStruct{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Wrong result\nexpected:\n%+v\nactual:\n%+v", expected, got)
+	}
+}
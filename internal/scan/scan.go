@@ -0,0 +1,283 @@
+// Package scan reads raw json tokens and reports where every value is located
+package scan
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thumbrise/validrator/internal/dot"
+	strings_thumbrise "github.com/thumbrise/validrator/internal/strings"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// Positions returns position of every value of json document by flat key, same keys as dot.Map produces.
+// Root value has empty key.
+func Positions(input []byte) (map[string]validation.Position, error) {
+	positions := make(map[string]validation.Position)
+
+	err := walk(input, nil, func(segments []interface{}, position validation.Position, _ bool) {
+		positions[dot.Join(segments)] = position
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return positions, nil
+}
+
+// Duplicate is value which key repeats earlier key of the same object.
+type Duplicate struct {
+	Segments []interface{}
+	Position validation.Position
+}
+
+// Duplicates returns every value which key repeats earlier key of the same object at any depth, in document order.
+// json.Unmarshal silently keeps the last of them. Keys of objects for which fold returns true are compared as field keys:
+// case-insensitively after conversion to camel case, so "first_name", "firstName" and "FirstName" are the same key.
+// Segments of object are passed to fold, nil fold compares all keys exactly.
+func Duplicates(input []byte, fold func(object []interface{}) bool) ([]Duplicate, error) {
+	duplicates := make([]Duplicate, 0)
+
+	err := walk(input, fold, func(segments []interface{}, position validation.Position, duplicate bool) {
+		if duplicate {
+			duplicates = append(duplicates, Duplicate{
+				Segments: segments,
+				Position: position,
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(duplicates, func(a, b Duplicate) int {
+		return cmp.Compare(a.Position.Offset, b.Position.Offset)
+	})
+
+	return duplicates, nil
+}
+
+// locator calculates line and column of offsets in one pass over input, offsets must be requested in ascending order.
+type locator struct {
+	input  []byte
+	offset int
+	line   int
+	column int
+}
+
+func newLocator(input []byte) *locator {
+	return &locator{input: input, line: 1, column: 1}
+}
+
+// at returns position of value located between start and end byte offsets.
+func (l *locator) at(start int, end int) validation.Position {
+	for ; l.offset < start; l.offset++ {
+		switch b := l.input[l.offset]; {
+		case b == '\n':
+			l.line++
+			l.column = 1
+		case utf8.RuneStart(b):
+			l.column++
+		}
+	}
+
+	return validation.Position{
+		Offset: start,
+		End:    end,
+		Line:   l.line,
+		Column: l.column,
+	}
+}
+
+type frame struct {
+	segments  []interface{}
+	position  validation.Position
+	duplicate bool
+	isObject  bool
+	expectKey bool
+	key       string
+	keys      map[string]bool
+	fold      bool
+	index     int
+}
+
+func (f *frame) childSegments() []interface{} {
+	segments := make([]interface{}, len(f.segments), len(f.segments)+1)
+	copy(segments, f.segments)
+
+	if f.isObject {
+		return append(segments, f.key)
+	}
+
+	return append(segments, f.index)
+}
+
+// identity returns current key of object as it is compared with other keys.
+func (f *frame) identity() string {
+	if f.fold {
+		return strings.ToLower(strings_thumbrise.ToCamel(f.key))
+	}
+
+	return f.key
+}
+
+func (f *frame) advance() {
+	if f.isObject {
+		f.expectKey = true
+
+		return
+	}
+
+	f.index++
+}
+
+// walk calls visit for every value with its segments and position. Segments of root value are empty.
+// Duplicate is true when key of value repeats earlier key of the same object, see Duplicates for fold.
+func walk(input []byte, fold func(object []interface{}) bool, visit func(segments []interface{}, position validation.Position, duplicate bool)) error {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	locator := newLocator(input)
+
+	stack := make([]*frame, 0)
+
+	for {
+		start := skipSeparators(input, int(decoder.InputOffset()))
+
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		var parent *frame
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+
+		delim, isDelim := token.(json.Delim)
+
+		switch {
+		case isDelim && (delim == '}' || delim == ']'):
+			stack = stack[:len(stack)-1]
+
+			parent.position.End = int(decoder.InputOffset())
+
+			visit(parent.segments, parent.position, parent.duplicate)
+
+			if len(stack) > 0 {
+				stack[len(stack)-1].advance()
+			}
+		case parent != nil && parent.expectKey:
+			parent.key, _ = token.(string)
+			parent.expectKey = false
+		default:
+			segments := make([]interface{}, 0)
+			duplicate := false
+
+			if parent != nil {
+				segments = parent.childSegments()
+				if parent.isObject {
+					key := parent.identity()
+					duplicate = parent.keys[key]
+					parent.keys[key] = true
+				}
+			}
+
+			if isDelim {
+				stack = append(stack, &frame{
+					segments:  segments,
+					position:  locator.at(start, start),
+					duplicate: duplicate,
+					isObject:  delim == '{',
+					expectKey: delim == '{',
+					keys:      make(map[string]bool),
+					fold:      delim == '{' && fold != nil && fold(segments),
+				})
+
+				continue
+			}
+
+			visit(segments, locator.at(start, int(decoder.InputOffset())), duplicate)
+
+			if parent != nil {
+				parent.advance()
+			}
+		}
+	}
+}
+
+func skipSeparators(input []byte, offset int) int {
+	for offset < len(input) {
+		switch input[offset] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
+
+// CheckLimits checks depth and array lengths of json document in one pass over its tokens, before it is decoded.
+// Exceeded limit is reported with error wrapping dot.ErrMaxDepth or dot.ErrMaxArrayLength, depth is counted as in dot.Flatten.
+func CheckLimits(input []byte, limits dot.Limits) error {
+	if limits.MaxDepth <= 0 && limits.MaxArrayLength <= 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+
+	// lengths are counts of elements of open arrays, open objects have -1
+	lengths := make([]int, 0)
+
+	for {
+		offset := decoder.InputOffset()
+
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		delim, isDelim := token.(json.Delim)
+		if isDelim && (delim == '}' || delim == ']') {
+			lengths = lengths[:len(lengths)-1]
+
+			continue
+		}
+
+		if limits.MaxDepth > 0 && len(lengths) > limits.MaxDepth {
+			return fmt.Errorf("%w: value at offset %d is nested deeper than %d", dot.ErrMaxDepth, offset, limits.MaxDepth)
+		}
+
+		if len(lengths) > 0 && lengths[len(lengths)-1] >= 0 {
+			lengths[len(lengths)-1]++
+
+			if limits.MaxArrayLength > 0 && lengths[len(lengths)-1] > limits.MaxArrayLength {
+				return fmt.Errorf("%w: array at depth %d has more than %d elements", dot.ErrMaxArrayLength, len(lengths), limits.MaxArrayLength)
+			}
+		}
+
+		switch {
+		case isDelim && delim == '[':
+			lengths = append(lengths, 0)
+		case isDelim && delim == '{':
+			lengths = append(lengths, -1)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package scan_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/dot"
+	"github.com/thumbrise/validrator/internal/scan"
+	"github.com/thumbrise/validrator/internal/testutil"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestPositions(t *testing.T) {
+	t.Parallel()
+
+	input := "{\n" +
+		"  \"name\" : \"имя\",\n" +
+		"  \"items\": [1, {\"a.b\": true}],\n" +
+		"  \"empty\": {}\n" +
+		"}"
+
+	want := map[string]validation.Position{
+		"":             {Offset: 0, End: 69, Line: 1, Column: 1},
+		"name":         {Offset: 13, End: 21, Line: 2, Column: 12},
+		"items":        {Offset: 34, End: 52, Line: 3, Column: 12},
+		"items.0":      {Offset: 35, End: 36, Line: 3, Column: 13},
+		"items.1":      {Offset: 38, End: 51, Line: 3, Column: 16},
+		"items.1.a~1b": {Offset: 46, End: 50, Line: 3, Column: 24},
+		"empty":        {Offset: 65, End: 67, Line: 4, Column: 12},
+	}
+
+	got, err := scan.Positions([]byte(input))
+	if err != nil {
+		t.Fatalf("Positions() unexpected error = %v", err)
+	}
+
+	diff := testutil.DiffAsJSON(want, got)
+	if diff != "" {
+		t.Errorf(
+			"positions not match\nexpected:\n%#v\nactual:\n%#v\ndiff:\n%s\n",
+			want,
+			got,
+			diff,
+		)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	t.Parallel()
+
+	input := `{"a": 1, "b": {"c": 1, "c": {"d": 1, "d": 2}}, "a": [1], "e": [{"f": 1, "f": 2}]}`
+
+	want := []scan.Duplicate{
+		{Segments: []interface{}{"b", "c"}, Position: validation.Position{Offset: 28, End: 44, Line: 1, Column: 29}},
+		{Segments: []interface{}{"b", "c", "d"}, Position: validation.Position{Offset: 42, End: 43, Line: 1, Column: 43}},
+		{Segments: []interface{}{"a"}, Position: validation.Position{Offset: 52, End: 55, Line: 1, Column: 53}},
+		{Segments: []interface{}{"e", 0, "f"}, Position: validation.Position{Offset: 77, End: 78, Line: 1, Column: 78}},
+	}
+
+	got, err := scan.Duplicates([]byte(input), nil)
+	if err != nil {
+		t.Fatalf("Duplicates() unexpected error = %v", err)
+	}
+
+	diff := testutil.DiffAsJSON(want, got)
+	if diff != "" {
+		t.Errorf(
+			"duplicates not match\nexpected:\n%#v\nactual:\n%#v\ndiff:\n%s\n",
+			want,
+			got,
+			diff,
+		)
+	}
+}
+
+func TestDuplicatesFolded(t *testing.T) {
+	t.Parallel()
+
+	input := `{"first_name": "a", "firstName": "b", "labels": {"Key": 1, "key": 2}, "NAME": 1, "name": 2}`
+
+	// Keys of root object are field keys, keys of labels are data
+	fold := func(object []interface{}) bool {
+		return len(object) == 0
+	}
+
+	got, err := scan.Duplicates([]byte(input), fold)
+	if err != nil {
+		t.Fatalf("Duplicates() unexpected error = %v", err)
+	}
+
+	gotSegments := make([][]interface{}, 0, len(got))
+	for _, duplicate := range got {
+		gotSegments = append(gotSegments, duplicate.Segments)
+	}
+
+	want := [][]interface{}{{"firstName"}, {"name"}}
+
+	diff := testutil.DiffAsJSON(want, gotSegments)
+	if diff != "" {
+		t.Errorf("duplicates not match\nexpected:\n%#v\nactual:\n%#v\ndiff:\n%s\n", want, gotSegments, diff)
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	t.Parallel()
+
+	input := `{"a": {"b": [1, 2, 3]}, "c": [[], {}]}`
+
+	tests := []struct {
+		name    string
+		limits  dot.Limits
+		wantErr error
+	}{
+		{name: "no limits", limits: dot.Limits{}, wantErr: nil},
+		{name: "limits are not exceeded", limits: dot.Limits{MaxDepth: 3, MaxArrayLength: 3}, wantErr: nil},
+		{name: "depth is exceeded", limits: dot.Limits{MaxDepth: 2}, wantErr: dot.ErrMaxDepth},
+		{name: "array length is exceeded", limits: dot.Limits{MaxArrayLength: 2}, wantErr: dot.ErrMaxArrayLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := scan.CheckLimits([]byte(input), tt.limits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckLimits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			_, _, flattenErr := dot.Flatten(decode(t, input), tt.limits)
+			if !errors.Is(flattenErr, tt.wantErr) {
+				t.Errorf("Flatten() error = %v, CheckLimits() must agree with it", flattenErr)
+			}
+		})
+	}
+}
+
+func decode(t *testing.T, input string) map[string]interface{} {
+	t.Helper()
+
+	result := make(map[string]interface{})
+
+	err := json.Unmarshal([]byte(input), &result)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+
+	return result
+}
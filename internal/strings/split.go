@@ -0,0 +1,69 @@
+package strings
+
+// Quote encloses argument of rule which is taken literally in tags, so separators inside quotes do not split it.
+// Quote opens only at start of argument, that is at start of s or after separator, ':' or ','. It closes only before
+// end of s, separator or ','. Quote inside quoted text is written twice. Other quotes, like in "oneof:don't,do",
+// and quote which is never closed are plain characters.
+const Quote = '\''
+
+// SplitUnquoted splits s by separator, which is ignored inside quotes. Quotes are kept in parts.
+func SplitUnquoted(s string, separator byte) []string {
+	parts := make([]string, 0)
+	start := 0
+	// literal is offset of opening quote which was never closed, so it is plain character
+	literal := -1
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == Quote && i != literal && opensQuote(s, i, separator):
+			end, ok := closingQuote(s, i, separator)
+			if !ok {
+				literal = i
+
+				continue
+			}
+
+			i = end
+		case s[i] == separator:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+func opensQuote(s string, i int, separator byte) bool {
+	return i == 0 || s[i-1] == separator || s[i-1] == ':' || s[i-1] == ','
+}
+
+// closingQuote returns offset of quote which closes quote opened at offset open.
+func closingQuote(s string, open int, separator byte) (int, bool) {
+	for i := open + 1; i < len(s); i++ {
+		if s[i] != Quote {
+			continue
+		}
+
+		if i+1 == len(s) || s[i+1] == separator || s[i+1] == ',' {
+			return i, true
+		}
+
+		// Doubled quote is escaped quote
+		if s[i+1] == Quote {
+			i++
+		}
+	}
+
+	return 0, false
+}
+
+// IsQuoted reports whether s is single argument enclosed in quotes, which SplitUnquoted keeps together.
+func IsQuoted(s string) bool {
+	if len(s) < 2 || s[0] != Quote { //nolint:mnd
+		return false
+	}
+
+	end, ok := closingQuote(s, 0, ',')
+
+	return ok && end == len(s)-1
+}
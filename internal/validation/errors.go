@@ -1,34 +1,200 @@
 package validation
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+
+	strings_thumbrise "github.com/thumbrise/validrator/internal/strings"
 )
 
-var errInvalidRule = errors.New("invalid rule")
+var (
+	// ErrUnknownRule is returned when rule has no registered handler.
+	ErrUnknownRule = errors.New("unknown rule")
+	// ErrInvalidRuleArgs is returned when arguments of rule can not be parsed by its handler.
+	// Handlers report it by panic with error wrapping ErrInvalidRuleArgs.
+	ErrInvalidRuleArgs = errors.New("invalid rule arguments")
+	// ErrValidation matches *Error with errors.Is.
+	ErrValidation = errors.New("validation failed")
+)
 
 // TagRequired define rule which returns in validation error when field empty or even does not exist.
 const TagRequired = "required"
 
+// RuleDuplicateKey is rule of fail reported for object key which repeats earlier key of the same object.
+const RuleDuplicateKey = "duplicate_key"
+
+// WarnPrefix marks rule which failure is reported as warning instead of error, for example "warn:max:500".
+const WarnPrefix = "warn:"
+
+// Severity of failed rule.
+type Severity string
+
+const (
+	// SeverityError rejects validated document.
+	SeverityError Severity = "error"
+	// SeverityWarning is reported, but does not reject validated document.
+	SeverityWarning Severity = "warning"
+)
+
 // FieldValidationFail is fail entry of field.
 type FieldValidationFail struct {
+	// Field is dot notation key of field as declared in structure. Keys containing dots or tildes are escaped, see FieldKey.
 	Field string
-	Rules []string
-	Value interface{}
+	// Path is location of field in validated document with original keys.
+	Path  Path
+	Rules []RuleFailure
+	// Value is actual value of field. It is RedactedValue when Redacted.
+	Value    interface{}
+	Redacted bool
+	// Position is location of value in raw json. It is known only when positions were recorded,
+	// missing field has position of the nearest existing parent.
+	Position Position
+}
+
+// RuleFailure is single failed rule of field.
+type RuleFailure struct {
+	// Name is rule name without arguments.
+	Name string
+	// Args are parsed arguments of rule, i.e. expected value.
+	Args []string
+	// Message is human-readable description of failure.
+	Message string
+	// Code is stable machine-readable identifier of rule.
+	Code     string
+	Severity Severity
 }
 
-// Error are set of fail entries.
+// NewRuleFailure constructor. Parses rule text to name and arguments.
+func NewRuleFailure(rule string) RuleFailure {
+	name, args := ParseRule(rule)
+
+	return RuleFailure{
+		Name:     name,
+		Args:     args,
+		Message:  ruleMessage(name, args),
+		Code:     ruleCode(name),
+		Severity: SeverityError,
+	}
+}
+
+func newWarningRuleFailure(rule string) RuleFailure {
+	failure := NewRuleFailure(rule)
+	failure.Severity = SeverityWarning
+
+	return failure
+}
+
+// String returns rule text as it was written in tag. Arguments with separators or in quotes are quoted.
+func (r RuleFailure) String() string {
+	if len(r.Args) == 0 {
+		return r.Name
+	}
+
+	args := make([]string, 0, len(r.Args))
+	for _, arg := range r.Args {
+		args = append(args, quoteArg(arg))
+	}
+
+	return r.Name + ":" + strings.Join(args, ",")
+}
+
+// quoteArg quotes argument only when it would not be read back as is: it contains separators or looks quoted itself.
+func quoteArg(arg string) string {
+	if !strings.ContainsAny(arg, ",|") && !strings_thumbrise.IsQuoted(arg) {
+		return arg
+	}
+
+	quote := string(strings_thumbrise.Quote)
+
+	return quote + strings.ReplaceAll(arg, quote, quote+quote) + quote
+}
+
+// RuleNames returns failed rules as they were written in tag.
+func (f FieldValidationFail) RuleNames() []string {
+	result := make([]string, 0, len(f.Rules))
+
+	for _, rule := range f.Rules {
+		result = append(result, rule.String())
+	}
+
+	return result
+}
+
+// Error are set of fail entries kept in document order.
 type Error struct {
+	// Failed are fail entries by field key.
+	//
+	// Deprecated: map loses document order, use Fields instead. Failed will be removed in the next release.
 	Failed map[string]FieldValidationFail
+
+	fails   []FieldValidationFail
+	omitted int
+}
+
+// NewError constructor. Fails are kept in given order.
+func NewError(fails []FieldValidationFail) *Error {
+	return &Error{Failed: failedByField(fails), fails: fails}
+}
+
+func failedByField(fails []FieldValidationFail) map[string]FieldValidationFail {
+	result := make(map[string]FieldValidationFail, len(fails))
+
+	for _, fail := range fails {
+		result[fail.Field] = fail
+	}
+
+	return result
+}
+
+// Fields returns fail entries in document order: declaration order of structure fields, then array index order.
+func (v *Error) Fields() []FieldValidationFail {
+	return v.fails
 }
 
-// ToMap godoc.
+// NewDocumentFail constructor of fail found in document structure itself, not by rule handler, for example duplicate key.
+// Segments are object keys and array indexes of path, maps are keys of map fields, see FieldKey.
+func NewDocumentFail(rule string, segments []interface{}, maps map[string]bool, position Position) FieldValidationFail {
+	path := NewPath(segments...)
+
+	return FieldValidationFail{
+		Field:    FieldKey(segments, maps),
+		Path:     path,
+		Rules:    []RuleFailure{NewRuleFailure(rule)},
+		Position: position,
+	}
+}
+
+// Truncate drops fails over maxFailures keeping the first ones. Zero means no limit.
+func (v *Error) Truncate(maxFailures int) {
+	if maxFailures <= 0 || len(v.fails) <= maxFailures {
+		return
+	}
+
+	v.omitted += len(v.fails) - maxFailures
+	v.fails = v.fails[:maxFailures]
+	v.Failed = failedByField(v.fails)
+}
+
+// Truncated reports whether some fails were dropped because of limit on count of reported fails.
+func (v *Error) Truncated() bool {
+	return v.omitted > 0
+}
+
+// Omitted returns count of fails dropped because of limit on count of reported fails.
+// Validation stops soon after the limit is reached, so it is lower bound of count of fails which were not reported.
+func (v *Error) Omitted() int {
+	return v.omitted
+}
+
+// ToMap returns failed rules as they were written in tag by field key.
 func (v *Error) ToMap() map[string][]string {
-	result := make(map[string][]string, len(v.Failed))
+	result := make(map[string][]string, len(v.fails))
 
-	for key, fail := range v.Failed {
-		result[key] = fail.Rules
+	for _, fail := range v.fails {
+		result[fail.Field] = fail.RuleNames()
 	}
 
 	return result
@@ -37,10 +203,50 @@ func (v *Error) ToMap() map[string][]string {
 func (v *Error) Error() string {
 	builder := strings.Builder{}
 
-	for fieldName, fail := range v.Failed {
-		rulesStr := strings.Join(fail.Rules, ", ")
-		builder.WriteString(fmt.Sprintf("field=%s rules=%s value=%+v\n", fieldName, rulesStr, fail.Value))
+	for _, fail := range v.fails {
+		rulesStr := strings.Join(fail.RuleNames(), ", ")
+		builder.WriteString(fmt.Sprintf("field=%s rules=%s value=%+v\n", fail.Field, rulesStr, fail.Value))
+	}
+
+	if v.omitted > 0 {
+		builder.WriteString(fmt.Sprintf("and %d more fields\n", v.omitted))
 	}
 
 	return builder.String()
 }
+
+// Is makes errors.Is(err, ErrValidation) true for any *Error.
+func (v *Error) Is(target error) bool {
+	return target == ErrValidation //nolint:errorlint
+}
+
+// MarshalJSON renders same object as ToMap, but keys are written in document order.
+func (v *Error) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+
+	buf.WriteByte('{')
+
+	for i, fail := range v.fails {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(fail.Field)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		rules, err := json.Marshal(fail.RuleNames())
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(rules)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/thumbrise/validrator/internal/dot"
+)
+
+// KeysPrefix marks rule which applies to every key of map instead of the map itself, for example "keys:uuid".
+// Failure is reported at the key with the key as value, its rule name keeps the prefix. When value of the same entry
+// fails too, both are reported as single fail with value of the entry, see mergeFails.
+const KeysPrefix = "keys:"
+
+// splitKeyRules separates rules with KeysPrefix from other rules. Prefix is trimmed.
+func splitKeyRules(ruleSet []string) ([]string, []string) {
+	valueRules := make([]string, 0, len(ruleSet))
+	keyRules := make([]string, 0)
+
+	for _, rule := range ruleSet {
+		if keyRule, ok := strings.CutPrefix(rule, KeysPrefix); ok {
+			keyRules = append(keyRules, keyRule)
+
+			continue
+		}
+
+		valueRules = append(valueRules, rule)
+	}
+
+	return valueRules, keyRules
+}
+
+// validateKeys validates keys of field by rules. Keys are escaped children of field in flat json, rules see them unescaped.
+func validateKeys(validatable *Validatable, fieldKey string, keys []string, ruleSet []string, newFailure func(rule string) RuleFailure) ([]FieldValidationFail, error) {
+	fails := make([]FieldValidationFail, 0)
+	if len(ruleSet) == 0 {
+		return fails, nil
+	}
+
+	resolve := func(rule string) ([]string, bool, error) {
+		return resolveReferences(validatable, fieldKey, rule)
+	}
+
+	for _, key := range slices.Sorted(slices.Values(keys)) {
+		rawKey := dot.Unescape(key)
+
+		keyErrs, err := validateField(reflect.ValueOf(rawKey), withoutMarkers(ruleSet), validatable.Handlers, resolve, newKeyRuleFailure(newFailure))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(keyErrs) > 0 {
+			fails = append(fails, newFieldValidationFail(validatable, joinKey(fieldKey, key), keyErrs, rawKey))
+		}
+	}
+
+	return fails, nil
+}
+
+func newKeyRuleFailure(newFailure func(rule string) RuleFailure) func(rule string) RuleFailure {
+	return func(rule string) RuleFailure {
+		failure := newFailure(rule)
+		failure.Name = KeysPrefix + failure.Name
+		failure.Code = ruleCode(failure.Name)
+		failure.Message = "key " + failure.Message
+
+		return failure
+	}
+}
+
+// mergeFails joins fails of the same field into the first of them, so key rules and value rules failed on one map entry
+// are reported once. Merged fail has value of the entry instead of the key.
+func mergeFails(fails []FieldValidationFail) []FieldValidationFail {
+	result := make([]FieldValidationFail, 0, len(fails))
+	indexes := make(map[string]int, len(fails))
+
+	for _, fail := range fails {
+		i, ok := indexes[fail.Field]
+		if !ok {
+			indexes[fail.Field] = len(result)
+			result = append(result, fail)
+
+			continue
+		}
+
+		merged := &result[i]
+		if isKeyFail(*merged) {
+			merged.Value = fail.Value
+		}
+
+		merged.Rules = append(slices.Clone(merged.Rules), fail.Rules...)
+	}
+
+	return result
+}
+
+// isKeyFail reports whether fail has only failures of key rules.
+func isKeyFail(fail FieldValidationFail) bool {
+	for _, rule := range fail.Rules {
+		if !strings.HasPrefix(rule.Name, KeysPrefix) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+var ruleMessages = map[string]string{
+	TagRequired:      "is required",
+	RuleDuplicateKey: "is duplicate key",
+	RuleUnknownField: "is unknown field",
+	"unique":         "must contain unique items",
+	"sorted":         "must be sorted in ascending order",
+	"sorted_desc":    "must be sorted in descending order",
+}
+
+// ruleArgsMessages are messages of rules with arguments, %s is replaced by arguments joined with ", ".
+var ruleArgsMessages = map[string]string{
+	"min_items":      "must contain at least %s items",
+	"max_items":      "must contain at most %s items",
+	"unique":         "must contain items with unique %s",
+	"contains_value": "must contain %s",
+	"subset_of":      "must contain only %s",
+}
+
+func ruleMessage(name string, args []string) string {
+	if message, ok := ruleArgsMessages[name]; ok && len(args) > 0 {
+		return fmt.Sprintf(message, strings.Join(args, ", "))
+	}
+
+	if message, ok := ruleMessages[name]; ok {
+		return message
+	}
+
+	if len(args) == 0 {
+		return fmt.Sprintf("must satisfy %q rule", name)
+	}
+
+	return fmt.Sprintf("must satisfy %q rule with %s", name, strings.Join(args, ", "))
+}
+
+// ruleCode converts rule name to snake case identifier: "equals 1" is "equals_1".
+func ruleCode(name string) string {
+	builder := strings.Builder{}
+	underscore := false
+
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+
+			underscore = false
+
+			continue
+		}
+
+		if !underscore && builder.Len() > 0 {
+			builder.WriteByte('_')
+
+			underscore = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "_")
+}
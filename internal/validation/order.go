@@ -0,0 +1,146 @@
+package validation
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	keySeparator = "."
+	wildcardKey  = "*"
+)
+
+// keyOrder compares field keys in document order. Named segments are compared by declaration order,
+// array indexes are compared numerically, parent goes before its children. Prepared keys are cached.
+type keyOrder struct {
+	ranks map[string]int
+	maps  map[string]bool
+	keys  map[string]orderKey
+}
+
+// orderKey is field key split once for repeated comparisons.
+type orderKey struct {
+	segments []string
+	// indexes are array indexes of segments, valid where isIndex is true.
+	indexes []int
+	isIndex []bool
+	// ranks are declaration ranks of key up to segment, valid where isRanked is true.
+	ranks    []int
+	isRanked []bool
+}
+
+func newKeyOrder(order []string, maps map[string]bool) *keyOrder {
+	ranks := make(map[string]int, len(order))
+	for i, key := range order {
+		ranks[key] = i
+	}
+
+	return &keyOrder{ranks: ranks, maps: maps, keys: make(map[string]orderKey)}
+}
+
+// sortFails sorts fails in document order, see keyOrder.
+func sortFails(fails []FieldValidationFail, order []string, maps map[string]bool) {
+	keys := newKeyOrder(order, maps)
+
+	slices.SortStableFunc(fails, func(a, b FieldValidationFail) int {
+		return keys.compare(a.Field, b.Field)
+	})
+}
+
+// exceeds reports whether fails located before key are enough to fill the limit, so fails of key and of keys after it
+// can not change reported ones. Limit keeps maxFailures fails and one more, which tells that error is truncated.
+// Zero maxFailures means no limit.
+func (o *keyOrder) exceeds(fails []FieldValidationFail, key string, maxFailures int) bool {
+	keep := maxFailures + 1
+	if maxFailures <= 0 || len(fails) < keep {
+		return false
+	}
+
+	before := 0
+
+	for _, fail := range fails {
+		if o.compare(fail.Field, key) < 0 {
+			before++
+		}
+	}
+
+	return before >= keep
+}
+
+func (o *keyOrder) compare(a, b string) int {
+	keyA, keyB := o.prepare(a), o.prepare(b)
+
+	for i := range min(len(keyA.segments), len(keyB.segments)) {
+		if keyA.segments[i] == keyB.segments[i] {
+			continue
+		}
+
+		if keyA.isIndex[i] && keyB.isIndex[i] {
+			return cmp.Compare(keyA.indexes[i], keyB.indexes[i])
+		}
+
+		switch {
+		case keyA.isRanked[i] && keyB.isRanked[i] && keyA.ranks[i] != keyB.ranks[i]:
+			return cmp.Compare(keyA.ranks[i], keyB.ranks[i])
+		case keyA.isRanked[i] && !keyB.isRanked[i]:
+			return -1
+		case !keyA.isRanked[i] && keyB.isRanked[i]:
+			return 1
+		default:
+			// Keys of the same map have the same rank
+			return cmp.Compare(keyA.segments[i], keyB.segments[i])
+		}
+	}
+
+	return cmp.Compare(len(keyA.segments), len(keyB.segments))
+}
+
+func (o *keyOrder) prepare(key string) orderKey {
+	if prepared, ok := o.keys[key]; ok {
+		return prepared
+	}
+
+	segments := strings.Split(key, keySeparator)
+	prepared := orderKey{
+		segments: segments,
+		indexes:  make([]int, len(segments)),
+		isIndex:  make([]bool, len(segments)),
+		ranks:    make([]int, len(segments)),
+		isRanked: make([]bool, len(segments)),
+	}
+
+	pattern := make([]string, len(segments))
+
+	for i, segment := range segments {
+		index, err := strconv.Atoi(segment)
+		prepared.indexes[i], prepared.isIndex[i] = index, err == nil
+
+		pattern[i] = segment
+		if err == nil || o.maps[strings.Join(pattern[:i], keySeparator)] {
+			pattern[i] = wildcardKey
+		}
+
+		prepared.ranks[i], prepared.isRanked[i] = o.ranks[strings.Join(pattern[:i+1], keySeparator)]
+	}
+
+	o.keys[key] = prepared
+
+	return prepared
+}
+
+// patternOf joins segments replacing array indexes and keys of maps with wildcard, so result is comparable with declared keys.
+func patternOf(segments []string, maps map[string]bool) string {
+	pattern := make([]string, len(segments))
+
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil || maps[strings.Join(pattern[:i], keySeparator)] {
+			segment = wildcardKey
+		}
+
+		pattern[i] = segment
+	}
+
+	return strings.Join(pattern, keySeparator)
+}
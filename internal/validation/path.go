@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PathSegment is single step of Path: object key or array index.
+type PathSegment struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+// Path is structured location of value inside of validated document.
+type Path []PathSegment
+
+// NewPath builds Path from segments, where string is object key and int is array index.
+func NewPath(segments ...interface{}) Path {
+	path := make(Path, 0, len(segments))
+
+	for _, segment := range segments {
+		switch casted := segment.(type) {
+		case int:
+			path = append(path, PathSegment{Index: casted, IsIndex: true})
+		case string:
+			path = append(path, PathSegment{Key: casted})
+		}
+	}
+
+	return path
+}
+
+// segments returns path as segments of NewPath.
+func (p Path) segments() []interface{} {
+	segments := make([]interface{}, 0, len(p))
+
+	for _, segment := range p {
+		if segment.IsIndex {
+			segments = append(segments, segment.Index)
+
+			continue
+		}
+
+		segments = append(segments, segment.Key)
+	}
+
+	return segments
+}
+
+// String godoc.
+func (p Path) String() string {
+	return p.Dot()
+}
+
+// JSONPointer renders path as RFC 6901 JSON Pointer (/items/0/name).
+func (p Path) JSONPointer() string {
+	builder := strings.Builder{}
+
+	for _, segment := range p {
+		builder.WriteByte('/')
+
+		if segment.IsIndex {
+			builder.WriteString(strconv.Itoa(segment.Index))
+
+			continue
+		}
+
+		key := strings.ReplaceAll(segment.Key, "~", "~0")
+		key = strings.ReplaceAll(key, "/", "~1")
+		builder.WriteString(key)
+	}
+
+	return builder.String()
+}
+
+// Dot renders path in dot notation (items.0.name).
+// Notice that keys containing dots are ambiguous in this notation.
+func (p Path) Dot() string {
+	parts := make([]string, 0, len(p))
+
+	for _, segment := range p {
+		if segment.IsIndex {
+			parts = append(parts, strconv.Itoa(segment.Index))
+
+			continue
+		}
+
+		parts = append(parts, segment.Key)
+	}
+
+	return strings.Join(parts, keySeparator)
+}
+
+// Bracket renders path in bracket notation (items[0].name). Keys which are not identifiers are quoted (items[0]["first.name"]).
+func (p Path) Bracket() string {
+	builder := strings.Builder{}
+
+	for i, segment := range p {
+		switch {
+		case segment.IsIndex:
+			builder.WriteByte('[')
+			builder.WriteString(strconv.Itoa(segment.Index))
+			builder.WriteByte(']')
+		case isIdentifier(segment.Key):
+			if i > 0 {
+				builder.WriteByte('.')
+			}
+
+			builder.WriteString(segment.Key)
+		default:
+			builder.WriteByte('[')
+			builder.WriteString(strconv.Quote(segment.Key))
+			builder.WriteByte(']')
+		}
+	}
+
+	return builder.String()
+}
+
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i, r := range key {
+		isLetter := r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+
+		if !isLetter && (i == 0 || !isDigit) {
+			return false
+		}
+	}
+
+	return true
+}
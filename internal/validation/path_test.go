@@ -0,0 +1,65 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		path        validation.Path
+		wantPointer string
+		wantDot     string
+		wantBracket string
+	}{
+		{
+			name:        "empty",
+			path:        validation.NewPath(),
+			wantPointer: "",
+			wantDot:     "",
+			wantBracket: "",
+		},
+		{
+			name:        "nested array",
+			path:        validation.NewPath("items", 0, "name"),
+			wantPointer: "/items/0/name",
+			wantDot:     "items.0.name",
+			wantBracket: "items[0].name",
+		},
+		{
+			name:        "special keys",
+			path:        validation.NewPath("a.b", "c/d", "e~f", 2),
+			wantPointer: "/a.b/c~1d/e~0f/2",
+			wantDot:     "a.b.c/d.e~f.2",
+			wantBracket: `["a.b"]["c/d"]["e~f"][2]`,
+		},
+		{
+			name:        "numeric key is not index",
+			path:        validation.NewPath("map", "10", "_id"),
+			wantPointer: "/map/10/_id",
+			wantDot:     "map.10._id",
+			wantBracket: `map["10"]._id`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.path.JSONPointer(); got != tt.wantPointer {
+				t.Errorf("JSONPointer() = %q, want %q", got, tt.wantPointer)
+			}
+
+			if got := tt.path.Dot(); got != tt.wantDot {
+				t.Errorf("Dot() = %q, want %q", got, tt.wantDot)
+			}
+
+			if got := tt.path.Bracket(); got != tt.wantBracket {
+				t.Errorf("Bracket() = %q, want %q", got, tt.wantBracket)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Position is location of value in raw json. Line and Column start from 1, Column counts runes.
+// Zero Line means position is unknown.
+type Position struct {
+	// Offset is byte offset of value start.
+	Offset int
+	// End is byte offset right after value end.
+	End    int
+	Line   int
+	Column int
+}
+
+// IsKnown reports whether position was recorded.
+func (p Position) IsKnown() bool {
+	return p.Line > 0
+}
+
+// String godoc.
+func (p Position) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// Excerpt renders line of source containing value with carets under the value:
+//
+//	3 |     "age": "ten",
+//	  |            ^^^^^
+//
+// Source must be the same input which was validated. Redacted value is masked in excerpt too.
+// Returns empty string when position is unknown.
+func (f FieldValidationFail) Excerpt(source []byte) string {
+	pos := f.Position
+	if !pos.IsKnown() || pos.Offset > len(source) {
+		return ""
+	}
+
+	lineStart := bytes.LastIndexByte(source[:pos.Offset], '\n') + 1
+
+	lineEnd := len(source)
+	if i := bytes.IndexByte(source[pos.Offset:], '\n'); i >= 0 {
+		lineEnd = pos.Offset + i
+	}
+
+	valueEnd := min(max(pos.End, pos.Offset), lineEnd)
+
+	prefix := string(source[lineStart:pos.Offset])
+	value := string(source[pos.Offset:valueEnd])
+	suffix := strings.TrimRight(string(source[valueEnd:lineEnd]), "\r")
+
+	if f.Redacted {
+		value = RedactedValue
+	}
+
+	number := strconv.Itoa(pos.Line)
+	gutter := strings.Repeat(" ", len(number))
+
+	builder := strings.Builder{}
+	builder.WriteString(number + " | " + prefix + value + suffix + "\n")
+	builder.WriteString(gutter + " | " + caretIndent(prefix) + strings.Repeat("^", max(utf8.RuneCountInString(value), 1)) + "\n")
+
+	return builder.String()
+}
+
+// caretIndent keeps tabs of prefix, so carets are aligned with value in any tab width.
+func caretIndent(prefix string) string {
+	builder := strings.Builder{}
+
+	for _, r := range prefix {
+		if r == '\t' {
+			builder.WriteRune(r)
+
+			continue
+		}
+
+		builder.WriteByte(' ')
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thumbrise/validrator/internal/dot"
+)
+
+// TagSensitive define marker which masks value of field and its nested fields in validation errors.
+const TagSensitive = "sensitive"
+
+// RedactedValue replaces values of sensitive fields.
+const RedactedValue = "[REDACTED]"
+
+// Redact returns RedactedValue instead of value of field which is sensitive, see TagSensitive and RedactPatterns,
+// and reports whether value was replaced. Validatable must be validated already, so rules are looked up by field keys.
+func Redact(validatable *Validatable, fieldKey string, value interface{}) (interface{}, bool) {
+	if !isSensitive(validatable, fieldKey) {
+		return value, false
+	}
+
+	return RedactedValue, true
+}
+
+// isSensitive reports whether field or any of its parents is marked as sensitive or matches redaction patterns.
+func isSensitive(validatable *Validatable, fieldKey string) bool {
+	parts := strings.Split(fieldKey, keySeparator)
+
+	for i := len(parts); i > 0; i-- {
+		key := strings.Join(parts[:i], keySeparator)
+
+		if slices.Contains(validatable.Rules[key], TagSensitive) {
+			return true
+		}
+	}
+
+	candidates := [][]string{
+		unescapeParts(parts),
+		pathParts(pathOf(validatable, fieldKey)),
+	}
+
+	for _, pattern := range validatable.RedactPatterns {
+		for _, candidate := range candidates {
+			if matchRedactPattern(pattern, candidate) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchRedactPattern reports whether pattern matches field or one of its parents given by path parts.
+// Pattern is split on dots and every part is case-insensitive glob of single path part, so star never crosses a part.
+// Pattern of single part matches part at any depth, longer pattern is anchored at the root.
+func matchRedactPattern(pattern string, parts []string) bool {
+	patternParts := strings.Split(strings.ToLower(pattern), keySeparator)
+
+	if len(patternParts) == 1 {
+		return slices.ContainsFunc(parts, func(part string) bool {
+			return matchGlob(patternParts[0], strings.ToLower(part))
+		})
+	}
+
+	if len(parts) < len(patternParts) {
+		return false
+	}
+
+	for i, patternPart := range patternParts {
+		if !matchGlob(patternPart, strings.ToLower(parts[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchGlob matches text by pattern, where "*" is any sequence of characters and "?" is any single character.
+func matchGlob(pattern, text string) bool {
+	patternRunes, textRunes := []rune(pattern), []rune(text)
+	star, starText := -1, 0
+	p, t := 0, 0
+
+	for t < len(textRunes) {
+		switch {
+		case p < len(patternRunes) && (patternRunes[p] == '?' || patternRunes[p] == textRunes[t]):
+			p++
+			t++
+		case p < len(patternRunes) && patternRunes[p] == '*':
+			star, starText = p, t
+			p++
+		case star >= 0:
+			starText++
+			p, t = star+1, starText
+		default:
+			return false
+		}
+	}
+
+	for p < len(patternRunes) && patternRunes[p] == '*' {
+		p++
+	}
+
+	return p == len(patternRunes)
+}
+
+func unescapeParts(parts []string) []string {
+	result := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		result = append(result, dot.Unescape(part))
+	}
+
+	return result
+}
+
+func pathParts(path Path) []string {
+	parts := make([]string, 0, len(path))
+
+	for _, segment := range path {
+		if segment.IsIndex {
+			parts = append(parts, strconv.Itoa(segment.Index))
+
+			continue
+		}
+
+		parts = append(parts, segment.Key)
+	}
+
+	return parts
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ReferencePrefix marks argument of rule listed in Validatable.ReferenceRules which is replaced by value of other field
+// of the same object, for example "before_or_equal:$endDate". Referenced key is camel case key relative to the object
+// and may be nested with dot notation, it must be declared in structure. Rule is skipped when referenced field is missing
+// or null and fails when referenced value is not valid argument of the rule. Quoted argument is never reference: "before:'$x'".
+// Arguments of other rules are taken literally, so "oneof:$5,$10" means "$5" or "$10".
+const ReferencePrefix = "$"
+
+// resolveReferences returns arguments of rule with references replaced by text of referenced values
+// and other arguments unquoted. False is returned when any referenced field is missing or null.
+// Reference to key which is not declared is error wrapping ErrInvalidRuleArgs.
+func resolveReferences(validatable *Validatable, fieldKey string, rule string) ([]string, bool, error) {
+	name, _ := ParseRule(rule)
+	if !validatable.ReferenceRules[name] {
+		return parseRuleArgs(rule), true, nil
+	}
+
+	ruleArgs := splitRuleArgs(rule)
+	resolved := make([]string, 0, len(ruleArgs))
+
+	for _, arg := range ruleArgs {
+		referenced, ok := strings.CutPrefix(arg, ReferencePrefix)
+		if !ok || referenced == "" {
+			resolved = append(resolved, unquoteArg(arg))
+
+			continue
+		}
+
+		key := referenced
+		if dotIndex := strings.LastIndex(fieldKey, keySeparator); dotIndex != -1 {
+			key = fieldKey[:dotIndex+1] + referenced
+		}
+
+		if !isDeclared(validatable, key) {
+			return nil, false, fmt.Errorf("%w: %s references undeclared field %s", ErrInvalidRuleArgs, fieldKey, key)
+		}
+
+		value, exists := validatable.JSON[key]
+		if !exists || value == nil {
+			return nil, false, nil
+		}
+
+		resolved = append(resolved, fmt.Sprint(value))
+	}
+
+	return resolved, true, nil
+}
+
+// isDeclared reports whether key matches declared key of structure.
+func isDeclared(validatable *Validatable, key string) bool {
+	return slices.Contains(validatable.Order, patternOf(strings.Split(key, keySeparator), validatable.Maps))
+}
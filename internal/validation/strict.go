@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"slices"
+	"strings"
+)
+
+// TagStrict marks structure which rejects unknown keys. Structure is marked by tag of its field or of its blank "_" field,
+// marker of slice field applies to elements.
+const TagStrict = "strict"
+
+// RuleUnknownField is rule of fail reported for object key which has no field in structure.
+const RuleUnknownField = "unknown_field"
+
+// unknownFields reports keys of strict objects which encoding/json does not decode to any field, see Validatable.Names.
+// Children of unknown key are not reported, as well as keys of objects without fixed set of fields, see Validatable.Objects.
+func unknownFields(validatable *Validatable) []FieldValidationFail {
+	strictKeys := make(map[string]bool)
+
+	for key, ruleSet := range validatable.Rules {
+		for _, rule := range ruleSet {
+			if rule == TagStrict {
+				strictKeys[key] = true
+				strictKeys[key+keySeparator+wildcardKey] = true
+			}
+		}
+	}
+
+	fails := make([]FieldValidationFail, 0)
+
+	for fieldKey, value := range validatable.JSON {
+		parts := strings.Split(fieldKey, keySeparator)
+		parent := patternOf(parts[:len(parts)-1], validatable.Maps)
+
+		if !validatable.Objects[parent] || (!validatable.Strict && !strictKeys[parent]) {
+			continue
+		}
+
+		if isKnownName(validatable.Names[parent], jsonKeyOf(validatable, fieldKey)) {
+			continue
+		}
+
+		fails = append(fails, newFieldValidationFail(validatable, fieldKey, []RuleFailure{NewRuleFailure(RuleUnknownField)}, value))
+	}
+
+	return fails
+}
+
+// jsonKeyOf returns original json key of the last segment of field key.
+func jsonKeyOf(validatable *Validatable, fieldKey string) string {
+	path := pathOf(validatable, fieldKey)
+	if len(path) == 0 {
+		return ""
+	}
+
+	return path[len(path)-1].Key
+}
+
+// isKnownName matches key with names of fields case-insensitively, like encoding/json does.
+func isKnownName(names []string, key string) bool {
+	return slices.ContainsFunc(names, func(name string) bool {
+		return strings.EqualFold(name, key)
+	})
+}
@@ -0,0 +1,188 @@
+package validation
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thumbrise/validrator/internal/dot"
+)
+
+// TreeSelfKey holds own failed rules of node which has failed nested fields too.
+const TreeSelfKey = "_errors"
+
+type treeNode struct {
+	rules    []string
+	children map[string]*treeNode
+	items    map[int]*treeNode
+}
+
+func newTreeNode() *treeNode {
+	return &treeNode{
+		children: make(map[string]*treeNode),
+		items:    make(map[int]*treeNode),
+	}
+}
+
+func (n *treeNode) child(segment PathSegment) *treeNode {
+	if segment.IsIndex {
+		if _, ok := n.items[segment.Index]; !ok {
+			n.items[segment.Index] = newTreeNode()
+		}
+
+		return n.items[segment.Index]
+	}
+
+	if _, ok := n.children[segment.Key]; !ok {
+		n.children[segment.Key] = newTreeNode()
+	}
+
+	return n.children[segment.Key]
+}
+
+func (n *treeNode) render() interface{} {
+	if len(n.children) == 0 && len(n.items) == 0 {
+		return n.rules
+	}
+
+	if len(n.children) == 0 && len(n.rules) == 0 {
+		result := make([]interface{}, slices.Max(slices.Collect(maps.Keys(n.items)))+1)
+
+		for index, item := range n.items {
+			result[index] = item.render()
+		}
+
+		return result
+	}
+
+	// Own rules or mixed children can not be represented by array, so indexes become object keys
+	result := make(map[string]interface{}, len(n.children)+len(n.items)+1)
+
+	for key, child := range n.children {
+		result[key] = child.render()
+	}
+
+	for index, item := range n.items {
+		result[strconv.Itoa(index)] = item.render()
+	}
+
+	if len(n.rules) > 0 {
+		result[TreeSelfKey] = n.rules
+	}
+
+	return result
+}
+
+// ToTree returns failed rules as nested object mirroring validated document: {"items":[{"name":["required"]}]}.
+// Objects are keyed by parts of field keys like Error.ToMap, so FlattenTree restores ToMap result.
+// Arrays are arrays, indexes without fails are nil. Node which failed itself and has failed nested fields
+// keeps own rules under TreeSelfKey, such array node becomes object with index keys.
+func (v *Error) ToTree() map[string]interface{} {
+	root := newTreeNode()
+
+	for _, fail := range v.fails {
+		node := root
+		for _, segment := range treeSegments(fail) {
+			node = node.child(segment)
+		}
+
+		node.rules = append(node.rules, fail.RuleNames()...)
+	}
+
+	if len(root.children) == 0 && len(root.items) == 0 && len(root.rules) == 0 {
+		return map[string]interface{}{}
+	}
+
+	rendered, ok := root.render().(map[string]interface{})
+	if !ok {
+		// Validated document is always object, so only failed root itself gets here
+		rendered = map[string]interface{}{TreeSelfKey: root.rules}
+	}
+
+	return rendered
+}
+
+// treeSegments returns path of fail with object keys replaced by parts of field key. Path keeps original keys
+// of document ("first_name"), while field key has keys of fields ("firstName").
+func treeSegments(fail FieldValidationFail) Path {
+	parts := strings.Split(fail.Field, keySeparator)
+	if fail.Field == "" || len(parts) != len(fail.Path) {
+		return fail.Path
+	}
+
+	segments := make(Path, len(fail.Path))
+
+	for i, segment := range fail.Path {
+		if !segment.IsIndex {
+			segment.Key = dot.Unescape(parts[i])
+		}
+
+		segments[i] = segment
+	}
+
+	return segments
+}
+
+// FlattenTree merges tree produced by Error.ToTree back to flat dot notation form, same as Error.ToMap.
+// Tree decoded from json is supported too.
+func FlattenTree(tree map[string]interface{}) map[string][]string {
+	result := make(map[string][]string)
+
+	flattenTreeRecursive(tree, nil, result)
+
+	return result
+}
+
+func flattenTreeRecursive(node interface{}, parts []string, output map[string][]string) {
+	if rules, ok := treeRules(node); ok {
+		key := strings.Join(parts, keySeparator)
+		output[key] = append(output[key], rules...)
+
+		return
+	}
+
+	switch casted := node.(type) {
+	case map[string]interface{}:
+		for key, child := range casted {
+			if key == TreeSelfKey {
+				flattenTreeRecursive(child, parts, output)
+
+				continue
+			}
+
+			flattenTreeRecursive(child, append(slices.Clone(parts), dot.Escape(key)), output)
+		}
+	case []interface{}:
+		for index, child := range casted {
+			if child == nil {
+				continue
+			}
+
+			flattenTreeRecursive(child, append(slices.Clone(parts), strconv.Itoa(index)), output)
+		}
+	}
+}
+
+// treeRules detects leaf of tree. Leaf is []string or, after json decoding, []interface{} of strings.
+func treeRules(node interface{}) ([]string, bool) {
+	switch casted := node.(type) {
+	case []string:
+		return casted, true
+	case []interface{}:
+		rules := make([]string, 0, len(casted))
+
+		for _, item := range casted {
+			rule, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+
+			rules = append(rules, rule)
+		}
+
+		return rules, len(rules) > 0
+	}
+
+	return nil, false
+}
@@ -0,0 +1,117 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestError_ToTree(t *testing.T) {
+	t.Parallel()
+
+	fail := func(rule string, segments ...interface{}) validation.FieldValidationFail {
+		path := validation.NewPath(segments...)
+
+		return validation.FieldValidationFail{
+			Field: path.Dot(),
+			Path:  path,
+			Rules: []validation.RuleFailure{validation.NewRuleFailure(rule)},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		fails []validation.FieldValidationFail
+		want  map[string]interface{}
+	}{
+		{
+			name:  "empty",
+			fails: nil,
+			want:  map[string]interface{}{},
+		},
+		{
+			name: "nested arrays and objects",
+			fails: []validation.FieldValidationFail{
+				fail("required", "name"),
+				fail("required", "items", 0, "name"),
+				fail("min:1", "items", 2, "price"),
+				fail("uuid", "owner", "id"),
+			},
+			want: map[string]interface{}{
+				"name": []string{"required"},
+				"items": []interface{}{
+					map[string]interface{}{"name": []string{"required"}},
+					nil,
+					map[string]interface{}{"price": []string{"min:1"}},
+				},
+				"owner": map[string]interface{}{"id": []string{"uuid"}},
+			},
+		},
+		{
+			name: "keys of fields",
+			fails: []validation.FieldValidationFail{
+				{Field: "firstName", Path: validation.NewPath("first_name"), Rules: []validation.RuleFailure{validation.NewRuleFailure("required")}},
+				{Field: "items.0.itemName", Path: validation.NewPath("items", 0, "item_name"), Rules: []validation.RuleFailure{validation.NewRuleFailure("required")}},
+				{Field: "labels.a~1b", Path: validation.NewPath("labels", "a.b"), Rules: []validation.RuleFailure{validation.NewRuleFailure("max:3")}},
+			},
+			want: map[string]interface{}{
+				"firstName": []string{"required"},
+				"items": []interface{}{
+					map[string]interface{}{"itemName": []string{"required"}},
+				},
+				"labels": map[string]interface{}{"a.b": []string{"max:3"}},
+			},
+		},
+		{
+			name: "node failed itself and nested fields",
+			fails: []validation.FieldValidationFail{
+				fail("max:2", "items"),
+				fail("required", "items", 1, "name"),
+				fail("strict", "owner"),
+				fail("uuid", "owner", "id"),
+			},
+			want: map[string]interface{}{
+				"items": map[string]interface{}{
+					validation.TreeSelfKey: []string{"max:2"},
+					"1":                    map[string]interface{}{"name": []string{"required"}},
+				},
+				"owner": map[string]interface{}{
+					validation.TreeSelfKey: []string{"strict"},
+					"id":                   []string{"uuid"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validationErrors := validation.NewError(tt.fails)
+
+			got := validationErrors.ToTree()
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToTree() mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(validationErrors.ToMap(), validation.FlattenTree(got)); diff != "" {
+				t.Errorf("FlattenTree() mismatch (-want +got):\n%s", diff)
+			}
+
+			encoded, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error = %v", err)
+			}
+
+			decoded := make(map[string]interface{})
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+			}
+
+			if diff := cmp.Diff(validationErrors.ToMap(), validation.FlattenTree(decoded)); diff != "" {
+				t.Errorf("FlattenTree() of decoded json mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
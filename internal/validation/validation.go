@@ -2,16 +2,20 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"reflect"
-	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/thumbrise/validrator/internal/dot"
 	strings_thumbrise "github.com/thumbrise/validrator/internal/strings"
 )
 
 // RuleHandlerFunc is type for custom handler.
+// Numbers of json input are passed as json.Number keeping their exact text, so handler decides how to compare them.
 type RuleHandlerFunc func(v reflect.Value, ruleArgs []string) bool
 
 // Validatable is type for input of validation.
@@ -19,131 +23,510 @@ type Validatable struct {
 	JSON     map[string]interface{}
 	Rules    map[string][]string
 	Handlers map[string]RuleHandlerFunc
+	// Order is declaration order of rule keys. Fails are sorted by it, when empty fails are sorted by key.
+	Order []string
+	// Paths are segments of JSON keys, see dot.Flatten. Keys without segments are split by dot.
+	Paths map[string][]interface{}
+	// RedactPatterns are case-insensitive globs of field keys, which values are masked in fails.
+	RedactPatterns []string
+	// Positions are locations of values in raw json by dot notation key with original JSON keys. Optional.
+	Positions map[string]Position
+	// MaxFailures caps count of reported fails, first fails in document order are kept. Zero means no limit.
+	// Rules of fields located after the first MaxFailures fails are not run.
+	MaxFailures int
+	// Objects are declared keys of objects with fixed set of fields, structure itself is empty key.
+	// Only their keys may be reported as unknown.
+	Objects map[string]bool
+	// Strict reports unknown keys of all Objects, otherwise only of ones marked with TagStrict.
+	Strict bool
+	// Names are json names of fields by declared key of their object, keys matching none of them case-insensitively are unknown.
+	Names map[string][]string
+	// Maps are declared keys of maps. Their keys are data, so they are not converted to camel case and are "*" in rule keys.
+	Maps map[string]bool
+	// ReferenceRules are names of rules which arguments may reference other fields, see ReferencePrefix.
+	ReferenceRules map[string]bool
 }
 
-const iterativeRuleRegexStr = ".*\\.\\*$"
-
-var iterativeRuleRegex = regexp.MustCompile(iterativeRuleRegexStr)
-
-const iterativeRuleCompatibleRegexStr = "(.*)(\\..*)$"
-
-var iterativeRuleCompatibleRegex = regexp.MustCompile(iterativeRuleCompatibleRegexStr)
-
+// unwrapIterativeRules replaces rules of keys with wildcards by rules of existing keys: "items.*.name" applies to
+// "items.0.name", "items.1.name" and so on for every element of "items", even when element has no "name".
 func unwrapIterativeRules(validatable *Validatable) {
 	newRules := make(map[string][]string)
+	children := childKeys(validatable.JSON)
 
 	for ruleKey, ruleSet := range validatable.Rules {
-		if !iterativeRuleRegex.MatchString(ruleKey) {
-			newRules[ruleKey] = ruleSet
+		segments := strings.Split(ruleKey, keySeparator)
+		if !slices.Contains(segments, wildcardKey) {
+			newRules[ruleKey] = slices.Concat(newRules[ruleKey], ruleSet)
 
 			continue
 		}
 
-		ruleUnprefixed := strings.TrimSuffix(ruleKey, ".*")
+		// Element presence is guaranteed by its index, so required is already satisfied
+		if segments[len(segments)-1] == wildcardKey {
+			ruleSet = withoutRequired(ruleSet)
+		}
+
+		for _, fieldKey := range expandWildcards(segments, children) {
+			newRules[fieldKey] = slices.Concat(newRules[fieldKey], ruleSet)
+		}
+	}
+
+	validatable.Rules = newRules
+}
+
+// childKeys returns last segments of keys by their parent keys, top level keys are children of empty key.
+// Dots of object keys are escaped in flat keys, so the last dot always separates the last segment.
+func childKeys(json map[string]interface{}) map[string][]string {
+	children := make(map[string][]string)
+
+	for key := range json {
+		parent, child := "", key
+		if i := strings.LastIndex(key, keySeparator); i >= 0 {
+			parent, child = key[:i], key[i+1:]
+		}
+
+		children[parent] = append(children[parent], child)
+	}
+
+	return children
+}
+
+// expandWildcards returns keys matching segments, where wildcard matches any child of existing key.
+func expandWildcards(segments []string, children map[string][]string) []string {
+	keys := []string{""}
+
+	for _, segment := range segments {
+		nextKeys := make([]string, 0, len(keys))
+
+		for _, key := range keys {
+			if segment != wildcardKey {
+				nextKeys = append(nextKeys, joinKey(key, segment))
 
-		for fieldKey := range validatable.JSON {
-			match := iterativeRuleCompatibleRegex.FindStringSubmatch(fieldKey)
-			if match == nil || len(match) <= 1 {
 				continue
 			}
 
-			fieldUnprefixed := match[1]
-
-			if ruleUnprefixed == fieldUnprefixed {
-				newRules[fieldKey] = ruleSet
+			for _, child := range children[key] {
+				nextKeys = append(nextKeys, joinKey(key, child))
 			}
 		}
+
+		keys = nextKeys
 	}
 
-	validatable.Rules = newRules
+	return keys
+}
+
+func joinKey(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+
+	return parent + keySeparator + child
 }
 
 func camelFieldKeys(validatable *Validatable) {
 	newFields := make(map[string]interface{})
+	newPaths := make(map[string][]interface{})
 
 	for key, value := range validatable.JSON {
-		newKey := strings_thumbrise.ToCamel(key)
+		segments, ok := validatable.Paths[key]
+		if !ok {
+			segments = keySegments(key)
+		}
+
+		newKey := FieldKey(segments, validatable.Maps)
 		newFields[newKey] = value
+
+		if ok {
+			newPaths[newKey] = segments
+		}
 	}
 
 	validatable.JSON = newFields
+	validatable.Paths = newPaths
 }
 
-// Validate method processes validation of map by rules.
+// FieldKey returns flat key of value located by segments in form of declared keys: object keys are converted to camel case
+// except keys of maps, which are data. Keys are escaped like in dot.Flatten, so keys containing dots are not confused with nesting.
+func FieldKey(segments []interface{}, maps map[string]bool) string {
+	key, _ := fieldKeyAndPattern(segments, maps)
+
+	return key
+}
+
+// KeyPattern works like FieldKey, but array indexes and keys of maps are replaced with wildcard,
+// so result is comparable with declared keys.
+func KeyPattern(segments []interface{}, maps map[string]bool) string {
+	_, pattern := fieldKeyAndPattern(segments, maps)
+
+	return pattern
+}
+
+func fieldKeyAndPattern(segments []interface{}, maps map[string]bool) (string, string) {
+	parts := make([]string, 0, len(segments))
+	pattern := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		switch casted := segment.(type) {
+		case int:
+			parts = append(parts, strconv.Itoa(casted))
+			pattern = append(pattern, wildcardKey)
+		case string:
+			if maps[strings.Join(pattern, keySeparator)] {
+				parts = append(parts, dot.Escape(casted))
+				pattern = append(pattern, wildcardKey)
+
+				continue
+			}
+
+			part := dot.Escape(strings_thumbrise.ToCamel(casted))
+			parts = append(parts, part)
+			pattern = append(pattern, part)
+		}
+	}
+
+	return strings.Join(parts, keySeparator), strings.Join(pattern, keySeparator)
+}
+
+// keySegments splits flat key to segments, parts which are integers are array indexes.
+func keySegments(key string) []interface{} {
+	parts := strings.Split(key, keySeparator)
+	segments := make([]interface{}, 0, len(parts))
+
+	for _, part := range parts {
+		if index, err := strconv.Atoi(part); err == nil {
+			segments = append(segments, index)
+
+			continue
+		}
+
+		segments = append(segments, dot.Unescape(part))
+	}
+
+	return segments
+}
+
+// pathOf returns path of field key with original JSON keys.
+// Missing fields extend path of the nearest existing parent by remaining key parts.
+func pathOf(validatable *Validatable, fieldKey string) Path {
+	parts := strings.Split(fieldKey, keySeparator)
+
+	for i := len(parts); i > 0; i-- {
+		segments, ok := validatable.Paths[strings.Join(parts[:i], keySeparator)]
+		if !ok {
+			continue
+		}
+
+		path := NewPath(segments...)
+
+		return append(path, NewPath(missingSegments(validatable, parts, i)...)...)
+	}
+
+	return NewPath(missingSegments(validatable, parts, 0)...)
+}
+
+// missingSegments returns segments of parts from start, which are not in document. Field keys are replaced with json names
+// of fields, so path points where client should send value: "first_name" instead of "firstName".
+func missingSegments(validatable *Validatable, parts []string, start int) []interface{} {
+	segments := make([]interface{}, 0, len(parts)-start)
+
+	for i := start; i < len(parts); i++ {
+		segments = append(segments, jsonNameOf(validatable, parts[:i], parts[i]))
+	}
+
+	return segments
+}
+
+// jsonNameOf returns json name of field by its key part. Name differing from the key only in case is not used,
+// because encoding/json matches names case-insensitively. Array indexes and keys of maps are returned unescaped.
+func jsonNameOf(validatable *Validatable, parent []string, part string) string {
+	for _, name := range validatable.Names[patternOf(parent, validatable.Maps)] {
+		if dot.Escape(strings_thumbrise.ToCamel(name)) == part && !strings.EqualFold(name, part) {
+			return name
+		}
+	}
+
+	return dot.Unescape(part)
+}
+
+// Validate method processes validation of map by rules. Failed warning rules are ignored.
 func Validate(validatable *Validatable) (*Error, error) {
+	validationErrors, _, err := ValidateWithWarnings(validatable)
+
+	return validationErrors, err
+}
+
+// ValidateWithWarnings method processes validation of map by rules. Rules with WarnPrefix are reported separately as warnings.
+func ValidateWithWarnings(validatable *Validatable) (*Error, *Error, error) {
 	camelFieldKeys(validatable)
+
+	// Strict markers are looked up by declared keys, so before unwrapping
+	validationErrors := unknownFields(validatable)
+
 	unwrapIterativeRules(validatable)
 
-	validationErrors := make(map[string]FieldValidationFail)
+	warnings := make([]FieldValidationFail, 0)
+	children := childKeys(validatable.JSON)
+	order := newKeyOrder(validatable.Order, validatable.Maps)
+
+	// Keys in document order make first returned error the same between runs and let validation stop at MaxFailures
+	fieldKeys := slices.SortedFunc(maps.Keys(validatable.Rules), order.compare)
+
+	// Fails before key only grow while keys advance, so done list stays done. List without rules is done from start
+	errorsDone, warningsDone := false, !hasWarningRules(validatable.Rules)
+
+	for _, fieldKey := range fieldKeys {
+		errorsDone = errorsDone || order.exceeds(validationErrors, fieldKey, validatable.MaxFailures)
+		warningsDone = warningsDone || order.exceeds(warnings, fieldKey, validatable.MaxFailures)
 
-	for fieldKey, ruleSet := range validatable.Rules {
+		if errorsDone && warningsDone {
+			break
+		}
+
+		errorRules, warningRules := splitBySeverity(validatable.Rules[fieldKey])
+		if errorsDone {
+			errorRules = nil
+		}
+
+		if warningsDone {
+			warningRules = nil
+		}
+
+		errorRules, errorKeyRules := splitKeyRules(errorRules)
+		warningRules, warningKeyRules := splitKeyRules(warningRules)
 		fieldValue, fieldExists := validatable.JSON[fieldKey]
 
 		// Handle empty or nil field
 		if !fieldExists || fieldValue == nil {
-			if slices.Contains(ruleSet, TagRequired) {
+			if slices.Contains(errorRules, TagRequired) {
 				// Else add required error
-				validationErrors[fieldKey] = FieldValidationFail{
-					Field: fieldKey,
-					Rules: []string{TagRequired},
-					Value: nil,
-				}
+				validationErrors = append(validationErrors, newFieldValidationFail(validatable, fieldKey, []RuleFailure{NewRuleFailure(TagRequired)}, nil))
+			}
+
+			if slices.Contains(warningRules, TagRequired) {
+				warnings = append(warnings, newFieldValidationFail(validatable, fieldKey, []RuleFailure{newWarningRuleFailure(TagRequired)}, nil))
 			}
 
 			continue
 		}
 
-		reflectedValue := reflect.ValueOf(fieldValue)
+		keyErrs, err := validateKeys(validatable, fieldKey, children[fieldKey], errorKeyRules, NewRuleFailure)
+		if err != nil {
+			return nil, nil, err
+		}
 
-		ruleSet = slices.DeleteFunc(ruleSet, func(s string) bool {
-			return s == TagRequired
-		})
+		keyWarnings, err := validateKeys(validatable, fieldKey, children[fieldKey], warningKeyRules, newWarningRuleFailure)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		validationErrors = append(validationErrors, keyErrs...)
+		warnings = append(warnings, keyWarnings...)
+
+		reflectedValue := reflect.ValueOf(fieldValue)
+		resolve := func(rule string) ([]string, bool, error) {
+			return resolveReferences(validatable, fieldKey, rule)
+		}
 
 		// Handle nested rules
-		fieldErrs, err := validateField(reflectedValue, ruleSet, validatable.Handlers)
+		fieldErrs, err := validateField(reflectedValue, withoutMarkers(errorRules), validatable.Handlers, resolve, NewRuleFailure)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if len(fieldErrs) > 0 {
-			validationErrors[fieldKey] = FieldValidationFail{
-				Field: fieldKey,
-				Rules: fieldErrs,
-				Value: fieldValue,
+			validationErrors = append(validationErrors, newFieldValidationFail(validatable, fieldKey, fieldErrs, fieldValue))
+		}
+
+		fieldWarnings, err := validateField(reflectedValue, withoutMarkers(warningRules), validatable.Handlers, resolve, newWarningRuleFailure)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(fieldWarnings) > 0 {
+			warnings = append(warnings, newFieldValidationFail(validatable, fieldKey, fieldWarnings, fieldValue))
+		}
+	}
+
+	return newSortedError(validationErrors, validatable), newSortedError(warnings, validatable), nil
+}
+
+func newSortedError(fails []FieldValidationFail, validatable *Validatable) *Error {
+	if len(fails) == 0 {
+		return nil
+	}
+
+	fails = mergeFails(fails)
+	sortFails(fails, validatable.Order, validatable.Maps)
+
+	validationErrors := NewError(fails)
+	validationErrors.Truncate(validatable.MaxFailures)
+
+	return validationErrors
+}
+
+// withoutRequired returns copy of rule set without required rule. Rule sets may be shared between keys, so they are never modified in place.
+func withoutRequired(ruleSet []string) []string {
+	return slices.DeleteFunc(slices.Clone(ruleSet), func(rule string) bool {
+		return strings.TrimPrefix(rule, WarnPrefix) == TagRequired
+	})
+}
+
+// hasWarningRules reports whether any field has rule with WarnPrefix.
+func hasWarningRules(rules map[string][]string) bool {
+	for _, ruleSet := range rules {
+		for _, rule := range ruleSet {
+			if strings.HasPrefix(rule, WarnPrefix) {
+				return true
 			}
 		}
 	}
 
-	if len(validationErrors) > 0 {
-		return &Error{
-			Failed: validationErrors,
-		}, nil
+	return false
+}
+
+// splitBySeverity separates rules with WarnPrefix from other rules. Prefix is trimmed.
+func splitBySeverity(ruleSet []string) ([]string, []string) {
+	errorRules := make([]string, 0, len(ruleSet))
+	warningRules := make([]string, 0)
+
+	for _, rule := range ruleSet {
+		if warningRule, ok := strings.CutPrefix(rule, WarnPrefix); ok {
+			warningRules = append(warningRules, warningRule)
+
+			continue
+		}
+
+		errorRules = append(errorRules, rule)
+	}
+
+	return errorRules, warningRules
+}
+
+// withoutMarkers returns copy of rule set without rules which have no handlers.
+func withoutMarkers(ruleSet []string) []string {
+	return slices.DeleteFunc(slices.Clone(ruleSet), func(rule string) bool {
+		return rule == TagRequired || rule == TagSensitive || rule == TagStrict
+	})
+}
+
+func newFieldValidationFail(validatable *Validatable, fieldKey string, rules []RuleFailure, value interface{}) FieldValidationFail {
+	fail := FieldValidationFail{
+		Field: fieldKey,
+		Path:  pathOf(validatable, fieldKey),
+		Rules: rules,
+		Value: value,
+	}
+
+	if isSensitive(validatable, fieldKey) {
+		fail.Value = RedactedValue
+		fail.Redacted = true
+	}
+
+	fail.Position = positionOf(validatable, fail.Path)
+
+	return fail
+}
+
+// positionOf returns position of path or position of its nearest existing parent.
+func positionOf(validatable *Validatable, path Path) Position {
+	for i := len(path); i >= 0; i-- {
+		if pos, ok := validatable.Positions[dot.Join(path[:i].segments())]; ok {
+			return pos
+		}
 	}
 
-	return nil, nil //nolint:nilnil
+	return Position{}
 }
 
-func validateField(value reflect.Value, ruleSet []string, handlers map[string]RuleHandlerFunc) ([]string, error) {
-	fieldErrs := make([]string, 0, 10)
+func validateField(value reflect.Value, ruleSet []string, handlers map[string]RuleHandlerFunc, resolve func(rule string) ([]string, bool, error), newFailure func(rule string) RuleFailure) ([]RuleFailure, error) {
+	fieldErrs := make([]RuleFailure, 0, len(ruleSet))
 
 	for _, rule := range ruleSet {
-		handler, ok := handlers[rule]
+		handler, ok := lookupHandler(handlers, rule)
 		if !ok {
-			return fieldErrs, fmt.Errorf("%w: %s", errInvalidRule, rule)
+			return fieldErrs, fmt.Errorf("%w: %s", ErrUnknownRule, rule)
+		}
+
+		ruleArgs, ok, err := resolve(rule)
+		if err != nil {
+			return fieldErrs, fmt.Errorf("%s: %w", rule, err)
 		}
 
-		ruleArgs := parseRuleArgs(rule)
-		if handler(value, ruleArgs) {
-			return fieldErrs, nil
+		if !ok {
+			continue
 		}
 
-		fieldErrs = append(fieldErrs, rule)
+		passed, err := callHandler(handler, value, ruleArgs)
+		if err != nil && !slices.Equal(parseRuleArgs(rule), ruleArgs) {
+			// Referenced value is input rather than rule, so invalid one fails the rule
+			passed, err = false, nil
+		}
+
+		if err != nil {
+			return fieldErrs, fmt.Errorf("%s: %w", rule, err)
+		}
+
+		if passed {
+			continue
+		}
+
+		fieldErrs = append(fieldErrs, newFailure(rule))
 	}
 
 	return fieldErrs, nil
 }
 
+// callHandler runs handler converting its ErrInvalidRuleArgs panic to error. Any other panic is propagated.
+func callHandler(handler RuleHandlerFunc, value reflect.Value, ruleArgs []string) (passed bool, err error) { //nolint:nonamedreturns
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		recoveredErr, ok := recovered.(error)
+		if !ok || !errors.Is(recoveredErr, ErrInvalidRuleArgs) {
+			panic(recovered)
+		}
+
+		err = recoveredErr
+	}()
+
+	return handler(value, ruleArgs), nil
+}
+
+// lookupHandler finds handler by rule name. Exact rule text is checked first, so handlers registered with arguments keep working.
+func lookupHandler(handlers map[string]RuleHandlerFunc, rule string) (RuleHandlerFunc, bool) {
+	if handler, ok := handlers[rule]; ok {
+		return handler, true
+	}
+
+	name, _ := ParseRule(rule)
+	handler, ok := handlers[name]
+
+	return handler, ok
+}
+
+// ParseRule splits rule to name and arguments: "oneof:a,b" is "oneof" and ["a", "b"].
+func ParseRule(rule string) (string, []string) {
+	name, _, _ := strings.Cut(rule, ":")
+
+	return name, parseRuleArgs(rule)
+}
+
 func parseRuleArgs(tag string) []string {
+	args := splitRuleArgs(tag)
+
+	for i, arg := range args {
+		args[i] = unquoteArg(arg)
+	}
+
+	return args
+}
+
+// splitRuleArgs returns arguments of rule as they were written. Argument in quotes may contain commas: "regex:'^[a,b]$'".
+func splitRuleArgs(tag string) []string {
 	colonIndex := strings.Index(tag, ":")
 	if colonIndex == -1 {
 		return []string{}
@@ -152,5 +535,16 @@ func parseRuleArgs(tag string) []string {
 	params := tag[colonIndex+1:]
 
 	// Разделяем параметры по запятой
-	return strings.Split(params, ",")
+	return strings_thumbrise.SplitUnquoted(params, ',')
+}
+
+// unquoteArg returns text of quoted argument with doubled quotes unescaped, other arguments are returned as is.
+func unquoteArg(arg string) string {
+	if !strings_thumbrise.IsQuoted(arg) {
+		return arg
+	}
+
+	quote := string(strings_thumbrise.Quote)
+
+	return strings.ReplaceAll(arg[1:len(arg)-1], quote+quote, quote)
 }
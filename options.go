@@ -0,0 +1,113 @@
+package validrator
+
+import (
+	"time"
+
+	"github.com/thumbrise/validrator/internal/handlers"
+)
+
+// Option configures Validrator, see NewValidrator.
+type Option func(v *Validrator)
+
+// WithRedaction masks values of fields matching any of patterns in validation errors and everything rendered from them.
+// Patterns are case-insensitive globs matched against dot notation key of field part by part, so "*" never crosses a dot.
+// Pattern without dots matches a part at any depth ("*password*"), pattern with dots is matched from the root ("user.*token*").
+// Nested fields of matched field are masked too.
+// Single field may be masked with "sensitive" tag marker instead.
+func WithRedaction(patterns ...string) Option {
+	return func(v *Validrator) {
+		v.redactPatterns = append(v.redactPatterns, patterns...)
+	}
+}
+
+// WithPositions records position of every value while parsing input, so validation fails carry line and column.
+// It costs additional pass over input.
+func WithPositions() Option {
+	return func(v *Validrator) {
+		v.positions = true
+	}
+}
+
+// Limits protect validation from oversized untrusted input. Zero field means no limit.
+type Limits struct {
+	// MaxInputSize is max size of input in bytes.
+	MaxInputSize int
+	// MaxDepth is max nesting of objects and arrays, {"a":{"b":1}} has depth 2.
+	MaxDepth int
+	// MaxArrayLength is max count of elements in any array.
+	MaxArrayLength int
+	// MaxFailures is max count of reported failed fields. Rest of fails is dropped, see validation.Error.Truncated.
+	MaxFailures int
+}
+
+// WithLimits bounds work on input. Input exceeding size, depth or array length limits is rejected with error wrapping ErrLimitExceeded.
+func WithLimits(limits Limits) Option {
+	return func(v *Validrator) {
+		v.limits = limits
+	}
+}
+
+// WithRejectDuplicateKeys reports every object key repeating earlier key of the same object at any depth as "duplicate_key" fail.
+// Keys of structure objects are compared after folding case and separators, so "first_name" and "firstName" are duplicates
+// when they fill the same field, keys of maps are compared as is.
+// Duplicates are reported before any rule runs, because json.Unmarshal silently keeps the last of them.
+func WithRejectDuplicateKeys() Option {
+	return func(v *Validrator) {
+		v.rejectDuplicateKeys = true
+	}
+}
+
+// WithStrict reports every key of input which has no field in output structure as "unknown_field" fail, including keys
+// of nested objects and array elements. Without this option only structures marked with "strict" tag reject unknown keys,
+// marker may be placed on field or on blank field of structure itself:
+//
+//	type Request struct {
+//		_    struct{} `validate:"strict"`
+//		Name string
+//	}
+//
+// Keys of maps and interface{} fields are never unknown.
+func WithStrict() Option {
+	return func(v *Validrator) {
+		v.strict = true
+	}
+}
+
+// WithCoercion converts input values to types of output fields before validation, for clients sending "42" instead of 42:
+// strings to numbers and bools, numbers to strings, single values to one element slices. Rules see converted values,
+// made conversions are listed in Report.Coercions. Values which can not be converted are left as is.
+func WithCoercion() Option {
+	return func(v *Validrator) {
+		v.coercion = true
+	}
+}
+
+// Resolver resolves host name to IP addresses for "safe_url" rule. *net.Resolver satisfies it.
+type Resolver = handlers.Resolver
+
+// WithResolver makes "safe_url" rule resolve host of URL and reject it when any of addresses is not public.
+// Without resolver only literal IP addresses are checked. Lookup of single value is bounded by WithResolveTimeout.
+func WithResolver(resolver Resolver) Option {
+	return func(v *Validrator) {
+		v.resolver = resolver
+	}
+}
+
+// WithResolveTimeout bounds lookup of single "safe_url" value by resolver of WithResolver, host not resolved in time is rejected.
+// Default timeout is 5 seconds.
+func WithResolveTimeout(timeout time.Duration) Option {
+	return func(v *Validrator) {
+		v.resolveTimeout = timeout
+	}
+}
+
+// Clock provides current time to time rules, see WithClock.
+type Clock = handlers.Clock
+
+// WithClock makes "now" arguments of time rules ("before:now+24h", "between:now-1h,now") and of lt, lte, gt, gte, min
+// and max rules of time.Time fields use clock instead of real time, for example to make them deterministic in tests.
+func WithClock(clock Clock) Option {
+	return func(v *Validrator) {
+		v.AddRuleHandlers(handlers.NewTimeHandlers(clock))
+	}
+}
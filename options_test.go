@@ -0,0 +1,155 @@
+package validrator_test
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+)
+
+func TestValidrator_ValidateLimits(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Items []int `validate:"[]positive"`
+		Deep  struct {
+			Inner struct {
+				Value int
+			}
+		}
+	}
+
+	tests := []struct {
+		name      string
+		limits    validrator.Limits
+		inputJSON string
+		wantErr   error
+		wantMap   map[string][]string
+		wantOmit  int
+		wantCalls int64
+	}{
+		{
+			name:      "input size",
+			limits:    validrator.Limits{MaxInputSize: 10},
+			inputJSON: `{"items": [1, 2, 3]}`,
+			wantErr:   validrator.ErrLimitExceeded,
+		},
+		{
+			name:      "depth",
+			limits:    validrator.Limits{MaxDepth: 2},
+			inputJSON: `{"deep": {"inner": {"value": 1}}}`,
+			wantErr:   validrator.ErrLimitExceeded,
+		},
+		{
+			name:      "array length",
+			limits:    validrator.Limits{MaxArrayLength: 2},
+			inputJSON: `{"items": [1, 2, 3]}`,
+			wantErr:   validrator.ErrLimitExceeded,
+		},
+		{
+			name:      "failures are truncated in document order",
+			limits:    validrator.Limits{MaxFailures: 2},
+			inputJSON: `{"items": [0, 1, 0, 0, 0, 0, 0]}`,
+			wantMap:   map[string][]string{"items.0": {"positive"}, "items.2": {"positive"}},
+			wantOmit:  1,
+			wantCalls: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int64
+
+			validator := validrator.NewValidrator(validrator.WithLimits(tt.limits))
+			validator.AddRuleHandler("positive", func(v reflect.Value, _ []string) bool {
+				calls.Add(1)
+
+				number, ok := validrator.NumberOf(v)
+
+				return ok && number > 0
+			})
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), &testStruct{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if validationErrors == nil {
+				t.Fatal("Validation errors are missing, but wanted")
+			}
+
+			if diff := cmp.Diff(tt.wantMap, validationErrors.ToMap()); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+
+			if !validationErrors.Truncated() || validationErrors.Omitted() != tt.wantOmit {
+				t.Errorf("Omitted() = %d, want %d", validationErrors.Omitted(), tt.wantOmit)
+			}
+
+			// Rules of elements after the limit are not run
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("rule calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateReportLimits(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Items []int `validate:"[]positive|[]warn:small"`
+	}
+
+	var positiveCalls, smallCalls atomic.Int64
+
+	validator := validrator.NewValidrator(validrator.WithLimits(validrator.Limits{MaxFailures: 2}))
+	validator.AddRuleHandler("positive", func(v reflect.Value, _ []string) bool {
+		positiveCalls.Add(1)
+
+		number, ok := validrator.NumberOf(v)
+
+		return ok && number > 0
+	})
+	validator.AddRuleHandler("small", func(v reflect.Value, _ []string) bool {
+		smallCalls.Add(1)
+
+		number, ok := validrator.NumberOf(v)
+
+		return ok && number < 5
+	})
+
+	report, err := validator.ValidateReport([]byte(`{"items": [0, 1, 0, 0, 0, 0, 0, 9, 9]}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("ValidateReport() unexpected error = %v", err)
+	}
+
+	if report.Errors == nil || report.Warnings == nil {
+		t.Fatal("Validation errors or warnings are missing, but wanted")
+	}
+
+	if diff := cmp.Diff(map[string][]string{"items.0": {"positive"}, "items.2": {"positive"}}, report.Errors.ToMap()); diff != "" {
+		t.Errorf("Errors mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string][]string{"items.7": {"small"}, "items.8": {"small"}}, report.Warnings.ToMap()); diff != "" {
+		t.Errorf("Warnings mismatch (-want +got):\n%s", diff)
+	}
+
+	// Error rules stop once errors are capped, warning rules keep running until warnings are capped
+	if positiveCalls.Load() != 4 {
+		t.Errorf("positive calls = %d, want 4", positiveCalls.Load())
+	}
+
+	if smallCalls.Load() != 9 {
+		t.Errorf("small calls = %d, want 9", smallCalls.Load())
+	}
+}
@@ -0,0 +1,115 @@
+package validrator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+// ProblemContentType is media type of RFC 9457 problem details document.
+const ProblemContentType = "application/problem+json"
+
+const (
+	defaultProblemType  = "about:blank"
+	defaultProblemTitle = "Validation failed"
+)
+
+// ProblemRenderer renders validation errors as RFC 9457 problem details. Zero value is ready to use.
+type ProblemRenderer struct {
+	// Type is URI identifying problem type. Default is "about:blank".
+	Type string
+	// RuleTypeBase is prefix of URI identifying every failed rule, for example "https://example.com/rules/".
+	// Rule name is appended to it. Empty value omits type of error entries.
+	RuleTypeBase string
+	// Title is short summary of problem type. Default is "Validation failed".
+	Title string
+	// Status is HTTP status code. Default is 422.
+	Status int
+	// Instance is URI identifying specific occurrence of problem.
+	Instance string
+}
+
+// Problem is RFC 9457 problem details document with "errors" extension member.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError is single failed rule of field.
+type ProblemError struct {
+	Type    string   `json:"type,omitempty"`
+	Pointer string   `json:"pointer"`
+	Rule    string   `json:"rule"`
+	Code    string   `json:"code"`
+	Params  []string `json:"params"`
+	Message string   `json:"message"`
+}
+
+// Render converts validation errors to problem details document. Every failed rule becomes own entry of errors.
+func (r ProblemRenderer) Render(validationErrors *validation.Error) *Problem {
+	problem := &Problem{
+		Type:     r.Type,
+		Title:    r.Title,
+		Status:   r.Status,
+		Instance: r.Instance,
+		Errors:   make([]ProblemError, 0),
+	}
+
+	if problem.Type == "" {
+		problem.Type = defaultProblemType
+	}
+
+	if problem.Title == "" {
+		problem.Title = defaultProblemTitle
+	}
+
+	if problem.Status == 0 {
+		problem.Status = http.StatusUnprocessableEntity
+	}
+
+	if validationErrors == nil {
+		return problem
+	}
+
+	fields := validationErrors.Fields()
+
+	for _, fail := range fields {
+		for _, rule := range fail.Rules {
+			entry := ProblemError{
+				Pointer: fail.Path.JSONPointer(),
+				Rule:    rule.Name,
+				Code:    rule.Code,
+				Params:  rule.Args,
+				Message: rule.Message,
+			}
+
+			if r.RuleTypeBase != "" {
+				entry.Type = r.RuleTypeBase + rule.Code
+			}
+
+			problem.Errors = append(problem.Errors, entry)
+		}
+	}
+
+	problem.Detail = fmt.Sprintf("%d field(s) failed validation", len(fields))
+	if validationErrors.Truncated() {
+		// Validation stops at the limit, so total count is unknown
+		problem.Detail = fmt.Sprintf("more than %d field(s) failed validation", len(fields))
+	}
+
+	return problem
+}
+
+// ServeHTTP writes problem as response with problem content type and its status.
+func (p *Problem) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+
+	_ = json.NewEncoder(w).Encode(p)
+}
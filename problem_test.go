@@ -0,0 +1,120 @@
+package validrator_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+func TestProblemRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name  string `validate:"required"`
+		Items []int  `validate:"[]between:1,5"`
+	}
+
+	validator := validrator.NewValidrator()
+	validator.AddRuleHandler("between:1,5", func(v reflect.Value, _ []string) bool {
+		number, ok := validrator.NumberOf(v)
+
+		return ok && number >= 1 && number <= 5
+	})
+
+	validationErrors, err := validator.Validate([]byte(`{"items": [1, 7]}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		renderer validrator.ProblemRenderer
+		want     string
+	}{
+		{
+			name:     "defaults",
+			renderer: validrator.ProblemRenderer{},
+			want: `{"type":"about:blank","title":"Validation failed","status":422,"detail":"2 field(s) failed validation","errors":[` +
+				`{"pointer":"/name","rule":"required","code":"required","params":[],"message":"is required"},` +
+				`{"pointer":"/items/1","rule":"between","code":"between","params":["1","5"],"message":"must satisfy \"between\" rule with 1, 5"}]}`,
+		},
+		{
+			name: "configured",
+			renderer: validrator.ProblemRenderer{
+				Type:         "https://example.com/problems/validation",
+				RuleTypeBase: "https://example.com/rules/",
+				Title:        "Invalid order",
+				Status:       http.StatusBadRequest,
+				Instance:     "/orders/1",
+			},
+			want: `{"type":"https://example.com/problems/validation","title":"Invalid order","status":400,` +
+				`"detail":"2 field(s) failed validation","instance":"/orders/1","errors":[` +
+				`{"type":"https://example.com/rules/required","pointer":"/name","rule":"required","code":"required","params":[],"message":"is required"},` +
+				`{"type":"https://example.com/rules/between","pointer":"/items/1","rule":"between","code":"between","params":["1","5"],"message":"must satisfy \"between\" rule with 1, 5"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(tt.renderer.Render(validationErrors))
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error = %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Render() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProblem_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	problem := validrator.ProblemRenderer{}.Render(validation.NewError(nil))
+
+	recorder := httptest.NewRecorder()
+	problem.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	if recorder.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusUnprocessableEntity)
+	}
+
+	if got := recorder.Header().Get("Content-Type"); got != validrator.ProblemContentType {
+		t.Errorf("Content-Type = %q, want %q", got, validrator.ProblemContentType)
+	}
+
+	want := `{"type":"about:blank","title":"Validation failed","status":422,"detail":"0 field(s) failed validation","errors":[]}` + "\n"
+	if diff := cmp.Diff(want, recorder.Body.String()); diff != "" {
+		t.Errorf("body mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestProblemRenderer_RenderTruncated(t *testing.T) {
+	t.Parallel()
+
+	fails := make([]validation.FieldValidationFail, 0)
+	for _, field := range []string{"a", "b", "c"} {
+		fails = append(fails, validation.FieldValidationFail{
+			Field: field,
+			Path:  validation.NewPath(field),
+			Rules: []validation.RuleFailure{validation.NewRuleFailure("required")},
+		})
+	}
+
+	validationErrors := validation.NewError(fails)
+	validationErrors.Truncate(2)
+
+	problem := validrator.ProblemRenderer{}.Render(validationErrors)
+
+	if want := "more than 2 field(s) failed validation"; problem.Detail != want {
+		t.Errorf("Detail = %q, want %q", problem.Detail, want)
+	}
+}
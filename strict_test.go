@@ -0,0 +1,124 @@
+package validrator_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+	"github.com/thumbrise/validrator/internal/validation"
+)
+
+type strictBase struct {
+	ID int `validate:"required"`
+}
+
+func TestValidrator_ValidateStrictJSONNames(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		strictBase
+		FullName string `json:"full_name" validate:"required"`
+		Secret   string `json:"-"`
+		hidden   string //nolint:unused
+	}
+
+	inputJSON := `{"id": 7, "FULL_NAME": "john", "secret": "s", "hidden": "h", "name": "j"}`
+
+	output := &testStruct{}
+
+	validationErrors, err := validrator.NewValidrator(validrator.WithStrict()).Validate([]byte(inputJSON), output)
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	want := map[string][]string{
+		"secret": {validation.RuleUnknownField},
+		"hidden": {validation.RuleUnknownField},
+		"name":   {validation.RuleUnknownField},
+	}
+	if diff := cmp.Diff(want, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+
+	validationErrors, err = validrator.NewValidrator().Validate([]byte(`{"id": 7, "full_name": "john"}`), output)
+	if err != nil || validationErrors != nil {
+		t.Fatalf("Validate() = %v, %v, want promoted and renamed fields to pass", validationErrors, err)
+	}
+
+	if output.ID != 7 || output.FullName != "john" {
+		t.Errorf("output = %+v, want decoded promoted and renamed fields", output)
+	}
+}
+
+func TestValidrator_ValidateStrict(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		City string
+	}
+
+	type item struct {
+		_    struct{} `validate:"strict"`
+		Name string
+	}
+
+	type testStruct struct {
+		Name    string `validate:"required"`
+		Address address
+		Items   []item
+		Extra   map[string]interface{}
+		Any     interface{}
+	}
+
+	inputJSON := `{
+		"name": "john",
+		"nickname": "jo",
+		"address": {"city": "Paris", "zip": "75001", "geo": {"lat": 1}},
+		"items": [{"name": "a"}, {"name": "b", "price": 1}],
+		"extra": {"whatever": 1},
+		"any": {"whatever": 1}
+	}`
+
+	tests := []struct {
+		name string
+		opts []validrator.Option
+		want []string
+	}{
+		{
+			name: "should report unknown keys of marked structures only",
+			want: []string{"/items/1/price"},
+		},
+		{
+			name: "should report every unknown key in strict mode",
+			opts: []validrator.Option{validrator.WithStrict()},
+			want: []string{"/address/geo", "/address/zip", "/items/1/price", "/nickname"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validationErrors, err := validrator.NewValidrator(tt.opts...).Validate([]byte(inputJSON), &testStruct{})
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			if validationErrors == nil {
+				t.Fatal("Validation errors are missing, but wanted")
+			}
+
+			actual := make([]string, 0)
+			for _, fail := range validationErrors.Fields() {
+				actual = append(actual, fail.Path.JSONPointer())
+
+				if fail.Rules[0].Name != validation.RuleUnknownField {
+					t.Errorf("rule of %s = %q, want %q", fail.Field, fail.Rules[0].Name, validation.RuleUnknownField)
+				}
+			}
+
+			if diff := cmp.Diff(tt.want, actual); diff != "" {
+				t.Errorf("unknown fields mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package validrator
+
+import "github.com/thumbrise/validrator/internal/validation"
+
+// FlattenErrorTree merges tree produced by validation.Error.ToTree back to flat dot notation form,
+// same as validation.Error.ToMap returns. Tree decoded from json is supported too.
+func FlattenErrorTree(tree map[string]interface{}) map[string][]string {
+	return validation.FlattenTree(tree)
+}
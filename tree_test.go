@@ -0,0 +1,71 @@
+package validrator_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/thumbrise/validrator"
+)
+
+func TestFlattenErrorTree(t *testing.T) {
+	t.Parallel()
+
+	type itemStruct struct {
+		ItemName string `json:"item_name" validate:"required"`
+		Quantity int    `json:"quantity_total" validate:"min:1"`
+	}
+
+	type testStruct struct {
+		FirstName string            `json:"first_name" validate:"min:3"`
+		LastName  string            `json:"last_name" validate:"required"`
+		Items     []itemStruct      `json:"line_items"`
+		Labels    map[string]string `json:"labels" validate:"[]max:3"`
+	}
+
+	inputJSON := `{"first_name": "jo", "line_items": [{"quantity_total": 0}], "labels": {"team_id": "backend"}}`
+
+	validator := validrator.NewValidrator()
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	// Present and missing fields of snake_case document are in the same key space
+	expectedTree := map[string]interface{}{
+		"firstName": []string{"min:3"},
+		"lastName":  []string{"required"},
+		"lineItems": []interface{}{
+			map[string]interface{}{"itemName": []string{"required"}, "quantityTotal": []string{"min:1"}},
+		},
+		"labels": map[string]interface{}{"team_id": []string{"max:3"}},
+	}
+
+	tree := validationErrors.ToTree()
+	if diff := cmp.Diff(expectedTree, tree); diff != "" {
+		t.Errorf("ToTree() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(validationErrors.ToMap(), validrator.FlattenErrorTree(tree)); diff != "" {
+		t.Errorf("FlattenErrorTree() mismatch (-want +got):\n%s", diff)
+	}
+
+	encoded, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+
+	decoded := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+
+	if diff := cmp.Diff(validationErrors.ToMap(), validrator.FlattenErrorTree(decoded)); diff != "" {
+		t.Errorf("FlattenErrorTree() of decoded json mismatch (-want +got):\n%s", diff)
+	}
+}
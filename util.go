@@ -3,23 +3,60 @@ package validrator
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
+	"fmt"
+	"reflect"
 )
 
 // JSONToStruct is converts io.Reader to golang struct.
 func jsonToStruct(input []byte, obj any) error {
 	decoder := json.NewDecoder(bytes.NewReader(input))
+
 	err := decoder.Decode(obj)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDecode, err)
+	}
 
-	return errors.Unwrap(err)
+	return nil
 }
 
-// JSONToMap is converts io.Reader to golang map.
+// JSONToMap is converts io.Reader to golang map. Numbers are decoded as json.Number, so they keep their exact text.
 func jsonToMap(input []byte, output map[string]interface{}) error {
-	err := json.Unmarshal(input, &output)
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&output)
 	if err != nil {
-		return errors.Unwrap(err)
+		return fmt.Errorf("%w: %w", ErrDecode, err)
 	}
 
 	return nil
 }
+
+// NumberOf reads number passed to custom rule handler as float64. Numbers of json input are passed to handlers
+// as json.Number, NumberOf accepts go numbers too. Notice that float64 rounds numbers above 2^53 and long fractions,
+// built-in rules compare json numbers exactly.
+func NumberOf(v reflect.Value) (float64, bool) {
+	if !v.IsValid() {
+		return 0, false
+	}
+
+	if number, ok := v.Interface().(json.Number); ok {
+		f, err := number.Float64()
+
+		return f, err == nil
+	}
+
+	if v.CanFloat() {
+		return v.Float(), true
+	}
+
+	if v.CanInt() {
+		return float64(v.Int()), true
+	}
+
+	if v.CanUint() {
+		return float64(v.Uint()), true
+	}
+
+	return 0, false
+}
@@ -8,11 +8,15 @@ package validrator
 
 import (
 	"encoding/json"
-	"errors"
+	"fmt"
 	"reflect"
+	"regexp"
+	"time"
 
 	"github.com/thumbrise/validrator/internal/dot"
+	"github.com/thumbrise/validrator/internal/handlers"
 	"github.com/thumbrise/validrator/internal/meta"
+	"github.com/thumbrise/validrator/internal/scan"
 	"github.com/thumbrise/validrator/internal/validation"
 )
 
@@ -21,49 +25,164 @@ var inBuiltHandlers = map[string]validation.RuleHandlerFunc{
 		return true
 	},
 }
-var errInvalidJSON = errors.New("invalid json")
+
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
 
 // Validrator is main struct of package. Create via constructor.
 type Validrator struct {
-	handlers map[string]validation.RuleHandlerFunc
+	handlers            map[string]validation.RuleHandlerFunc
+	redactPatterns      []string
+	positions           bool
+	limits              Limits
+	rejectDuplicateKeys bool
+	strict              bool
+	coercion            bool
+	patterns            map[string]*regexp.Regexp
+	resolver            Resolver
+	resolveTimeout      time.Duration
 }
 
-// NewValidrator constructor.
-func NewValidrator() *Validrator {
+// NewValidrator constructor. Built-in rules are registered, custom handlers added later override them.
+func NewValidrator(opts ...Option) *Validrator {
 	r := &Validrator{
 		handlers: make(map[string]validation.RuleHandlerFunc),
+		patterns: make(map[string]*regexp.Regexp),
 	}
+	r.AddRuleHandlers(handlers.BuiltInHandlers)
 	r.AddRuleHandlers(inBuiltHandlers)
+	r.AddRuleHandler("pattern", handlers.NewNamedPattern(r.patterns))
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	// Resolver and its timeout may be set by options in any order
+	if r.resolver != nil {
+		r.AddRuleHandler("safe_url", handlers.NewSafeURL(r.resolver, r.resolveTimeout))
+	}
 
 	return r
 }
 
 // Validate method processes validation by structure tags and marshall to that struct.
 func (v *Validrator) Validate(input []byte, output any) (*validation.Error, error) {
+	report, err := v.ValidateReport(input, output)
+	if err != nil {
+		return nil, err
+	}
+
+	return report.Errors, nil
+}
+
+// Report is detailed result of validation.
+type Report struct {
+	// Errors are failed rules. Output is not decoded when there are errors.
+	Errors *validation.Error
+	// Warnings are failed rules marked with "warn:" prefix, for example "warn:max:500". They do not prevent decoding to output.
+	Warnings *validation.Error
+	// Coercions are conversions of input values made in coercion mode, see WithCoercion.
+	Coercions []Coercion
+}
+
+// ValidateReport works like Validate, but also reports warnings.
+func (v *Validrator) ValidateReport(input []byte, output any) (*Report, error) {
+	if v.limits.MaxInputSize > 0 && len(input) > v.limits.MaxInputSize {
+		return nil, fmt.Errorf("%w: input has %d bytes, limit is %d", ErrLimitExceeded, len(input), v.limits.MaxInputSize)
+	}
+
 	if !json.Valid(input) {
-		return nil, errInvalidJSON
+		return nil, ErrInvalidJSON
+	}
+
+	// Depth and array lengths are checked before input is decoded to map
+	err := scan.CheckLimits(input, v.flattenLimits())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLimitExceeded, err)
 	}
 
 	// Preparing validation. Need handlers map and jsonInput map
-	rules := collectRules(output)
+	schema := collectSchema(output)
+
+	if v.rejectDuplicateKeys {
+		duplicatesErrors, err := v.collectDuplicates(input, schema)
+		if duplicatesErrors != nil || err != nil {
+			return &Report{Errors: duplicatesErrors}, err
+		}
+	}
+
+	jsonMap := make(map[string]interface{})
 
-	jsonInput, err := collectJSONMap(input)
+	err = jsonToMap(input, jsonMap)
 	if err != nil {
-		return nil, errors.Unwrap(err)
+		return nil, err
+	}
+
+	var coercions []Coercion
+
+	if v.coercion {
+		jsonMap, coercions = schema.coerce(jsonMap)
+	}
+
+	jsonInput, paths, err := v.flattenJSONMap(jsonMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var positions map[string]validation.Position
+
+	if v.positions {
+		positions, err = scan.Positions(input)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDecode, err)
+		}
 	}
 
-	validationErrors, err := validateReal(jsonInput, rules, v.handlers)
-	if validationErrors != nil || err != nil {
-		return validationErrors, errors.Unwrap(err)
+	report, err := v.validateReal(jsonInput, paths, positions, schema, coercions)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.Errors != nil {
+		return report, nil
+	}
+
+	var durations []Coercion
+
+	// Duration strings are decoded as nanoseconds, rules have seen them as sent
+	if schema.hasType(durationType) {
+		jsonMap, durations = schema.convert(jsonMap, durationScalar)
+	}
+
+	// Coerced values are decoded instead of sent ones
+	if len(coercions) > 0 || len(durations) > 0 {
+		input, err = json.Marshal(jsonMap)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDecode, err)
+		}
 	}
 
 	// Mapping to struct
 	err = jsonToStruct(input, output)
 	if err != nil {
-		return nil, errors.Unwrap(err)
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Check works like Validate, but returns single error. Validation errors are returned as *validation.Error,
+// so they match ErrValidation with errors.Is and can be extracted with errors.As.
+func (v *Validrator) Check(input []byte, output any) error {
+	validationErrors, err := v.Validate(input, output)
+	if err != nil {
+		return err
+	}
+
+	if validationErrors != nil {
+		return validationErrors
 	}
 
-	return nil, nil //nolint:nilnil
+	return nil
 }
 
 // AddRuleHandler register new custom rule with handler function.
@@ -71,6 +190,19 @@ func (v *Validrator) AddRuleHandler(rule string, handlerFunc validation.RuleHand
 	v.handlers[rule] = handlerFunc
 }
 
+// RegisterPattern registers RE2 pattern under name for "pattern" rule, so tags say "pattern:sku" instead of repeating the pattern.
+// Registered pattern replaces previous one with the same name. Like handlers, patterns should be registered before validation.
+func (v *Validrator) RegisterPattern(name, pattern string) error {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidPattern, name, err)
+	}
+
+	v.patterns[name] = regex
+
+	return nil
+}
+
 // AddRuleHandlers register new custom rules with handler functions.
 func (v *Validrator) AddRuleHandlers(handlers map[string]validation.RuleHandlerFunc) {
 	for rule, handlerFunc := range handlers {
@@ -78,32 +210,128 @@ func (v *Validrator) AddRuleHandlers(handlers map[string]validation.RuleHandlerF
 	}
 }
 
-func collectJSONMap(input []byte) (map[string]interface{}, error) {
-	jsonMap := make(map[string]interface{})
+// collectDuplicates reports repeated keys. Keys of structures are compared as field keys, because all of them are decoded
+// to the same field, keys of maps and of other objects are compared exactly.
+func (v *Validrator) collectDuplicates(input []byte, schema *schema) (*validation.Error, error) {
+	objects := schema.objects()
+	maps := schema.maps()
 
-	err := jsonToMap(input, jsonMap)
+	duplicates, err := scan.Duplicates(input, func(object []interface{}) bool {
+		return objects[validation.KeyPattern(object, maps)]
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %w", ErrDecode, err)
+	}
+
+	if len(duplicates) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	fails := make([]validation.FieldValidationFail, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		fails = append(fails, validation.NewDocumentFail(validation.RuleDuplicateKey, duplicate.Segments, maps, duplicate.Position))
+	}
+
+	duplicatesErrors := validation.NewError(fails)
+	duplicatesErrors.Truncate(v.limits.MaxFailures)
+
+	return duplicatesErrors, nil
+}
+
+func (v *Validrator) flattenJSONMap(jsonMap map[string]interface{}) (map[string]interface{}, map[string][]interface{}, error) {
+	result, paths, err := dot.Flatten(jsonMap, v.flattenLimits())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrLimitExceeded, err)
 	}
 
-	result := dot.Map(jsonMap)
+	return result, paths, nil
+}
 
-	return result, nil
+func (v *Validrator) flattenLimits() dot.Limits {
+	return dot.Limits{
+		MaxDepth:       v.limits.MaxDepth,
+		MaxArrayLength: v.limits.MaxArrayLength,
+	}
 }
 
-// ValidateJSON method processes validation of map by handlers.
-func validateReal(data map[string]interface{}, rules map[string][]string, handlers map[string]validation.RuleHandlerFunc) (*validation.Error, error) {
+// validateReal method processes validation of map by handlers. Coercions are reported with values of sensitive fields masked.
+func (v *Validrator) validateReal(data map[string]interface{}, paths map[string][]interface{}, positions map[string]validation.Position, schema *schema, coercions []Coercion) (*Report, error) {
 	input := &validation.Validatable{
-		JSON:     data,
-		Rules:    rules,
-		Handlers: handlers,
+		JSON:           data,
+		Paths:          paths,
+		Rules:          schema.rules,
+		Handlers:       v.handlers,
+		Order:          schema.order,
+		RedactPatterns: v.redactPatterns,
+		Positions:      positions,
+		MaxFailures:    v.limits.MaxFailures,
+		Objects:        schema.objects(),
+		Strict:         v.strict,
+		Names:          schema.names,
+		Maps:           schema.maps(),
+		ReferenceRules: handlers.ReferenceRules,
 	}
 
-	return validation.Validate(input) //nolint:wrapcheck
+	validationErrors, warnings, err := validation.ValidateWithWarnings(input)
+
+	return &Report{Errors: validationErrors, Warnings: warnings, Coercions: redactCoercions(input, coercions)}, err //nolint:wrapcheck
 }
 
-func collectRules(output any) map[string][]string {
+// schema is meta information of output structure.
+type schema struct {
+	rules map[string][]string
+	// order is declaration order of keys.
+	order []string
+	// types are types of keys, type of structure itself is under empty key.
+	types map[string]reflect.Type
+	// names are json names of fields by key of their structure.
+	names map[string][]string
+}
+
+func collectSchema(output any) *schema {
 	tagCollector := meta.NewTagsCollector("validate")
 
-	return tagCollector.Extract(output)
+	return &schema{
+		rules: tagCollector.Extract(output),
+		order: tagCollector.Keys(output),
+		types: tagCollector.Types(output),
+		names: tagCollector.Names(output),
+	}
+}
+
+// objects returns keys of structures. Structures decoded by own json.Unmarshaler, like time.Time, have no fixed set of fields.
+func (s *schema) objects() map[string]bool {
+	result := make(map[string]bool)
+
+	for key, typ := range s.types {
+		if typ.Kind() == reflect.Struct && !reflect.PointerTo(typ).Implements(unmarshalerType) {
+			result[key] = true
+		}
+	}
+
+	return result
+}
+
+// maps returns keys of maps.
+func (s *schema) maps() map[string]bool {
+	result := make(map[string]bool)
+
+	for key, typ := range s.types {
+		if typ.Kind() == reflect.Map {
+			result[key] = true
+		}
+	}
+
+	return result
+}
+
+// hasType reports whether any field has type typ.
+func (s *schema) hasType(typ reflect.Type) bool {
+	for _, fieldType := range s.types {
+		if fieldType == typ {
+			return true
+		}
+	}
+
+	return false
 }
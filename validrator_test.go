@@ -2,9 +2,15 @@
 package validrator_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/netip"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/thumbrise/validrator"
@@ -21,11 +27,9 @@ func TestValidrator_Validate(t *testing.T) {
 		name: "equals 1",
 		handler: func(v reflect.Value, _ []string) bool {
 			const validValue = 1
-			r := (v.CanFloat() && v.Float() == validValue) ||
-				(v.CanInt() && v.Int() == validValue) ||
-				(v.CanComplex() && v.Complex() == validValue)
+			number, ok := validrator.NumberOf(v)
 
-			return r
+			return ok && number == validValue
 		},
 	}
 	handlers := map[string]validation.RuleHandlerFunc{
@@ -389,3 +393,1160 @@ func TestValidrator_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidrator_ValidateOrder(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"positive": func(v reflect.Value, _ []string) bool {
+			number, ok := validrator.NumberOf(v)
+
+			return ok && number > 0
+		},
+	}
+
+	type testStruct struct {
+		Zeta   int `validate:"required|positive"`
+		Middle struct {
+			Second int `validate:"positive"`
+			First  int `validate:"positive"`
+		} `validate:"required"`
+		Alpha []int `validate:"[]positive"`
+	}
+
+	inputJSON := `{
+		"alpha": [0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 0],
+		"middle": {"first": 0, "second": 0}
+	}`
+
+	expectedFields := []string{
+		"zeta",
+		"middle.second",
+		"middle.first",
+		"alpha.0",
+		"alpha.2",
+		"alpha.10",
+	}
+
+	expectedError := "field=zeta rules=required value=<nil>\n" +
+		"field=middle.second rules=positive value=0\n" +
+		"field=middle.first rules=positive value=0\n" +
+		"field=alpha.0 rules=positive value=0\n" +
+		"field=alpha.2 rules=positive value=0\n" +
+		"field=alpha.10 rules=positive value=0\n"
+
+	expectedJSON := `{"zeta":["required"],"middle.second":["positive"],"middle.first":["positive"],` +
+		`"alpha.0":["positive"],"alpha.2":["positive"],"alpha.10":["positive"]}`
+
+	for range 20 {
+		validator := validrator.NewValidrator()
+		validator.AddRuleHandlers(handlers)
+
+		validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+		if err != nil {
+			t.Fatalf("Validate() unexpected error = %v", err)
+		}
+
+		if validationErrors == nil {
+			t.Fatal("Validation errors are missing, but wanted")
+		}
+
+		actualFields := make([]string, 0, len(validationErrors.Fields()))
+		for _, fail := range validationErrors.Fields() {
+			actualFields = append(actualFields, fail.Field)
+		}
+
+		if diff := cmp.Diff(expectedFields, actualFields); diff != "" {
+			t.Fatalf("Fields() order mismatch (-want +got):\n%s", diff)
+		}
+
+		for _, field := range expectedFields {
+			if _, ok := validationErrors.Failed[field]; !ok { //nolint:staticcheck
+				t.Fatalf("Failed is missing deprecated entry of %s", field)
+			}
+		}
+
+		if diff := cmp.Diff(expectedError, validationErrors.Error()); diff != "" {
+			t.Fatalf("Error() mismatch (-want +got):\n%s", diff)
+		}
+
+		actualJSON, err := json.Marshal(validationErrors)
+		if err != nil {
+			t.Fatalf("json.Marshal() unexpected error = %v", err)
+		}
+
+		if diff := cmp.Diff(expectedJSON, string(actualJSON)); diff != "" {
+			t.Fatalf("json.Marshal() mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestValidrator_ValidatePaths(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"positive": func(v reflect.Value, _ []string) bool {
+			number, ok := validrator.NumberOf(v)
+
+			return ok && number > 0
+		},
+	}
+
+	type testStruct struct {
+		FirstName string `validate:"positive"`
+		Missing   struct {
+			Inner int `validate:"required"`
+		}
+		Items  []int `validate:"[]positive"`
+		Nested struct {
+			Value int `validate:"positive"`
+		}
+		Labels map[string]int `validate:"[]positive"`
+	}
+
+	inputJSON := `{
+		"first_name": "name",
+		"items": [1, 0],
+		"nested.value": 5,
+		"nested": {"value": 0},
+		"labels": {"a.b": 0, "c/d~e": 0, "ok": 1}
+	}`
+
+	expected := map[string][3]string{
+		"firstName":     {"/first_name", "first_name", "first_name"},
+		"missing.inner": {"/missing/inner", "missing.inner", "missing.inner"},
+		"items.1":       {"/items/1", "items.1", "items[1]"},
+		"nested.value":  {"/nested/value", "nested.value", "nested.value"},
+		"labels.a~1b":   {"/labels/a.b", "labels.a.b", `labels["a.b"]`},
+		"labels.c/d~0e": {"/labels/c~1d~0e", "labels.c/d~e", `labels["c/d~e"]`},
+	}
+
+	validator := validrator.NewValidrator()
+	validator.AddRuleHandlers(handlers)
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	actual := make(map[string][3]string)
+	for _, fail := range validationErrors.Fields() {
+		actual[fail.Field] = [3]string{fail.Path.JSONPointer(), fail.Path.Dot(), fail.Path.Bracket()}
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("paths mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateMissingPaths(t *testing.T) {
+	t.Parallel()
+
+	type itemStruct struct {
+		ItemID int `json:"item_id" validate:"required"`
+	}
+
+	type testStruct struct {
+		FirstName string       `json:"first_name" validate:"required"`
+		LastName  string       `validate:"required"`
+		Items     []itemStruct `json:"line_items"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	validationErrors, err := validator.Validate([]byte(`{"line_items": [{"item": 1}]}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	// Missing fields are pointed by json names, which client sends
+	expected := map[string]string{
+		"firstName":          "/first_name",
+		"lastName":           "/lastName",
+		"lineItems.0.itemId": "/line_items/0/item_id",
+	}
+
+	actual := make(map[string]string)
+	for _, fail := range validationErrors.Fields() {
+		actual[fail.Field] = fail.Path.JSONPointer()
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("paths mismatch (-want +got):\n%s", diff)
+	}
+
+	pointers := make([]string, 0)
+	for _, problemError := range (validrator.ProblemRenderer{}).Render(validationErrors).Errors {
+		pointers = append(pointers, problemError.Pointer)
+	}
+
+	if diff := cmp.Diff([]string{"/first_name", "/lastName", "/line_items/0/item_id"}, pointers); diff != "" {
+		t.Errorf("problem pointers mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateRuleFailures(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"max_len": func(v reflect.Value, args []string) bool {
+			limit, err := strconv.Atoi(args[0])
+
+			return err == nil && v.Len() <= limit
+		},
+		"Starts With": func(v reflect.Value, args []string) bool {
+			return len(args) == 1 && strings.HasPrefix(v.String(), args[0])
+		},
+	}
+
+	type testStruct struct {
+		Code string `validate:"max_len:3|Starts With:x"`
+	}
+
+	validator := validrator.NewValidrator()
+	validator.AddRuleHandlers(handlers)
+
+	validationErrors, err := validator.Validate([]byte(`{"code": "abcd"}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expectedRules := []validation.RuleFailure{
+		{Name: "max_len", Args: []string{"3"}, Message: `must satisfy "max_len" rule with 3`, Code: "max_len", Severity: validation.SeverityError},
+		{Name: "Starts With", Args: []string{"x"}, Message: `must satisfy "Starts With" rule with x`, Code: "starts_with", Severity: validation.SeverityError},
+	}
+
+	if diff := cmp.Diff(expectedRules, validationErrors.Fields()[0].Rules); diff != "" {
+		t.Errorf("Rules mismatch (-want +got):\n%s", diff)
+	}
+
+	expectedMap := map[string][]string{"code": {"max_len:3", "Starts With:x"}}
+	if diff := cmp.Diff(expectedMap, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateAllRules(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name    string `validate:"min:1|max:3|alpha"`
+		Age     int    `validate:"min:18|max:60"`
+		Contact string `validate:"alpha|email"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	// First rule of every field passes, so stopping at first passed rule hides rest of fails
+	validationErrors, err := validator.Validate([]byte(`{"name": "john1", "age": 70, "contact": "john"}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expected := map[string][]string{
+		"name":    {"max:3", "alpha"},
+		"age":     {"max:60"},
+		"contact": {"email"},
+	}
+
+	if diff := cmp.Diff(expected, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateRedaction(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"long": func(v reflect.Value, _ []string) bool {
+			return v.Kind() == reflect.String && v.Len() >= 8
+		},
+	}
+
+	type testStruct struct {
+		Login        string `validate:"long"`
+		UserPassword string `validate:"long"`
+		Card         struct {
+			Number string `validate:"long"`
+		} `validate:"sensitive"`
+		Pin     string            `validate:"sensitive|long"`
+		Headers map[string]string `validate:"[]long"`
+		Session struct {
+			Tokens []string `validate:"[]long"`
+			Name   string   `validate:"long"`
+		}
+	}
+
+	inputJSON := `{
+		"login": "admin",
+		"userPassword": "hunter2",
+		"card": {"number": "4111"},
+		"pin": "1234",
+		"headers": {"x-auth/password": "s3cret", "accept": "*/*"},
+		"session": {"tokens": ["t0k"], "name": "guest"}
+	}`
+
+	validator := validrator.NewValidrator(validrator.WithRedaction("*PASSWORD*", "session.*TOKEN*"))
+	validator.AddRuleHandlers(handlers)
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expected := map[string]interface{}{
+		"login":                   "admin",
+		"userPassword":            validation.RedactedValue,
+		"card.number":             validation.RedactedValue,
+		"pin":                     validation.RedactedValue,
+		"headers.x-auth/password": validation.RedactedValue,
+		"headers.accept":          "*/*",
+		"session.tokens.0":        validation.RedactedValue,
+		"session.name":            "guest",
+	}
+
+	actual := make(map[string]interface{})
+	for _, fail := range validationErrors.Fields() {
+		actual[fail.Field] = fail.Value
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("values mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, secret := range []string{"hunter2", "4111", "1234", "s3cret", "t0k"} {
+		if strings.Contains(validationErrors.Error(), secret) {
+			t.Errorf("Error() contains sensitive value %q:\n%s", secret, validationErrors.Error())
+		}
+	}
+}
+
+func TestValidrator_ValidatePositions(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"positive": func(v reflect.Value, _ []string) bool {
+			number, ok := validrator.NumberOf(v)
+
+			return ok && number > 0
+		},
+	}
+
+	type testStruct struct {
+		Name  string `validate:"required"`
+		Age   int    `validate:"positive"`
+		Token string `validate:"sensitive|positive"`
+	}
+
+	inputJSON := "{\n" +
+		"\t\"age\": -1,\n" +
+		"\t\"token\": \"secret\"\n" +
+		"}"
+
+	validator := validrator.NewValidrator(validrator.WithPositions())
+	validator.AddRuleHandlers(handlers)
+
+	validationErrors, err := validator.Validate([]byte(inputJSON), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expected := map[string]string{
+		"name": "1 | {\n" +
+			"  | ^\n",
+		"age": "2 | \t\"age\": -1,\n" +
+			"  | \t       ^^\n",
+		"token": "3 | \t\"token\": [REDACTED]\n" +
+			"  | \t         ^^^^^^^^^^\n",
+	}
+
+	actual := make(map[string]string)
+	for _, fail := range validationErrors.Fields() {
+		actual[fail.Field] = fail.Excerpt([]byte(inputJSON))
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("excerpts mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := validationErrors.Fields()[1].Position.String(); got != "line 2, column 9" {
+		t.Errorf("Position.String() = %q, want %q", got, "line 2, column 9")
+	}
+}
+
+func TestValidrator_ValidateReportWarnings(t *testing.T) {
+	t.Parallel()
+
+	handlers := map[string]validation.RuleHandlerFunc{
+		"short": func(v reflect.Value, _ []string) bool {
+			return v.Kind() == reflect.String && v.Len() <= 5
+		},
+	}
+
+	type testStruct struct {
+		Name        string `validate:"required|short"`
+		Description string `validate:"warn:short"`
+		Nickname    string `validate:"warn:required"`
+	}
+
+	tests := []struct {
+		name             string
+		inputJSON        string
+		expectedErrors   map[string][]string
+		expectedWarnings map[string][]string
+		expectedOutput   *testStruct
+	}{
+		{
+			name:             "warnings only should decode output",
+			inputJSON:        `{"name": "Bob", "description": "too long description"}`,
+			expectedErrors:   nil,
+			expectedWarnings: map[string][]string{"description": {"short"}, "nickname": {"required"}},
+			expectedOutput:   &testStruct{Name: "Bob", Description: "too long description"},
+		},
+		{
+			name:             "errors and warnings should not decode output",
+			inputJSON:        `{"name": "Robert", "description": "too long description", "nickname": "Bob"}`,
+			expectedErrors:   map[string][]string{"name": {"short"}},
+			expectedWarnings: map[string][]string{"description": {"short"}},
+			expectedOutput:   &testStruct{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+			validator.AddRuleHandlers(handlers)
+
+			actualOutput := &testStruct{}
+
+			report, err := validator.ValidateReport([]byte(tt.inputJSON), actualOutput)
+			if err != nil {
+				t.Fatalf("ValidateReport() unexpected error = %v", err)
+			}
+
+			var actualErrors map[string][]string
+			if report.Errors != nil {
+				actualErrors = report.Errors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.expectedErrors, actualErrors); diff != "" {
+				t.Errorf("Errors mismatch (-want +got):\n%s", diff)
+			}
+
+			if report.Warnings == nil {
+				t.Fatal("Warnings are missing, but wanted")
+			}
+
+			if diff := cmp.Diff(tt.expectedWarnings, report.Warnings.ToMap()); diff != "" {
+				t.Errorf("Warnings mismatch (-want +got):\n%s", diff)
+			}
+
+			for _, fail := range report.Warnings.Fields() {
+				if fail.Rules[0].Severity != validation.SeverityWarning {
+					t.Errorf("Severity of %s = %q, want %q", fail.Field, fail.Rules[0].Severity, validation.SeverityWarning)
+				}
+			}
+
+			if diff := cmp.Diff(tt.expectedOutput, actualOutput); diff != "" {
+				t.Errorf("output mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateNumberPrecision(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		ID     uint64  `validate:"eq:9007199254740993"`
+		Amount string  `validate:"required"`
+		Price  float64 `validate:"max:0.3"`
+	}
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+	}{
+		{
+			name:      "should compare numbers without rounding",
+			inputJSON: `{"id": 9007199254740993, "amount": "1", "price": 0.3}`,
+		},
+		{
+			name:      "should reject neighbours hidden by float rounding",
+			inputJSON: `{"id": 9007199254740992, "amount": "1", "price": 0.30000000000000001}`,
+			want:      map[string][]string{"id": {"eq:9007199254740993"}, "price": {"max:0.3"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+
+			output := &testStruct{}
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), output)
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			if tt.want == nil {
+				if validationErrors != nil {
+					t.Fatalf("Validate() unexpected validation errors = %v", validationErrors)
+				}
+
+				if output.ID != 9007199254740993 {
+					t.Errorf("ID = %d, want 9007199254740993", output.ID)
+				}
+
+				return
+			}
+
+			if validationErrors == nil {
+				t.Fatal("Validation errors are missing, but wanted")
+			}
+
+			if diff := cmp.Diff(tt.want, validationErrors.ToMap()); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeResolver map[string]string
+
+func (r fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	addr, err := netip.ParseAddr(r[host])
+
+	return []netip.Addr{addr}, err //nolint:wrapcheck
+}
+
+func TestValidrator_ValidateSafeURL(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Webhook string `validate:"safe_url:https"`
+	}
+
+	resolver := fakeResolver{"hooks.example.com": "203.0.114.1", "intranet.example.com": "10.0.0.1"}
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+	}{
+		{
+			name:      "should accept host resolved to public address",
+			inputJSON: `{"webhook": "https://hooks.example.com/notify"}`,
+		},
+		{
+			name:      "should reject host resolved to private address",
+			inputJSON: `{"webhook": "https://intranet.example.com/notify"}`,
+			want:      map[string][]string{"webhook": {"safe_url:https"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator(validrator.WithResolver(resolver))
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), &testStruct{})
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type blockingResolver struct{}
+
+func (blockingResolver) LookupNetIP(ctx context.Context, _, _ string) ([]netip.Addr, error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err() //nolint:wrapcheck
+}
+
+func TestValidrator_ValidateSafeURLTimeout(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Webhook string `validate:"safe_url"`
+	}
+
+	// Timeout is applied regardless of options order
+	validator := validrator.NewValidrator(
+		validrator.WithResolveTimeout(10*time.Millisecond),
+		validrator.WithResolver(blockingResolver{}),
+	)
+
+	start := time.Now()
+
+	validationErrors, err := validator.Validate([]byte(`{"webhook": "https://slow.example.com"}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Validate() took %v, want resolve timeout to bound it", elapsed)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expected := map[string][]string{"webhook": {"safe_url"}}
+	if diff := cmp.Diff(expected, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestValidrator_ValidateTime(t *testing.T) {
+	t.Parallel()
+
+	type slotStruct struct {
+		Start string `json:"start" validate:"required|after:now|weekday"`
+		End   string `json:"end" validate:"required|after:$start|before_or_equal:now+720h"`
+	}
+
+	type testStruct struct {
+		Slot       slotStruct `json:"slot"`
+		ReportedAt string     `json:"reported_at" validate:"datetime:RFC3339|between:now-24h,now"`
+	}
+
+	clock := fixedClock(time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+	}{
+		{
+			name:      "should pass times relative to clock and sibling field",
+			inputJSON: `{"slot": {"start": "2025-06-16T09:00:00Z", "end": "2025-06-16T10:00:00Z"}, "reported_at": "2025-06-15T08:00:00Z"}`,
+		},
+		{
+			name:      "should compare with sibling field",
+			inputJSON: `{"slot": {"start": "2025-06-17", "end": "2025-06-16"}}`,
+			want:      map[string][]string{"slot.end": {"after:$start"}},
+		},
+		{
+			name:      "should fail times out of clock ranges",
+			inputJSON: `{"slot": {"start": "2025-06-14", "end": "2025-08-01"}, "reported_at": "2025-06-13T08:00:00Z"}`,
+			want: map[string][]string{
+				"slot.start": {"after:now", "weekday"},
+				"slot.end":   {"before_or_equal:now+720h"},
+				"reportedAt": {"between:now-24h,now"},
+			},
+		},
+		{
+			name:      "should fail comparison with invalid sibling",
+			inputJSON: `{"slot": {"start": "soon", "end": "2025-06-17"}}`,
+			want:      map[string][]string{"slot.start": {"after:now", "weekday"}, "slot.end": {"after:$start"}},
+		},
+		{
+			name:      "should skip comparison with missing sibling",
+			inputJSON: `{"slot": {"end": "2025-06-17"}}`,
+			want:      map[string][]string{"slot.start": {"required"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator(validrator.WithClock(clock))
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), &testStruct{})
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateReferences(t *testing.T) {
+	t.Parallel()
+
+	type planStruct struct {
+		Plan string `json:"plan" validate:"oneof:$5,$10"`
+	}
+
+	type undeclaredStruct struct {
+		End string `json:"end" validate:"after:$start"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	validationErrors, err := validator.Validate([]byte(`{"plan": "free", "start": "2025-01-01"}`), &planStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if diff := cmp.Diff(map[string][]string{"plan": {"oneof:$5,$10"}}, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+
+	validationErrors, err = validator.Validate([]byte(`{"plan": "$10"}`), &planStruct{})
+	if err != nil || validationErrors != nil {
+		t.Errorf("Validate() = %v, %v, want literal argument to pass", validationErrors, err)
+	}
+
+	_, err = validator.Validate([]byte(`{"end": "2025-01-02", "start": "2025-01-01"}`), &undeclaredStruct{})
+	if !errors.Is(err, validrator.ErrInvalidRuleArgs) {
+		t.Errorf("Validate() error = %v, want ErrInvalidRuleArgs for undeclared reference", err)
+	}
+}
+
+func TestValidrator_ValidateDuration(t *testing.T) {
+	t.Parallel()
+
+	type retryStruct struct {
+		Delay time.Duration `json:"delay" validate:"duration|duration_min:1s"`
+	}
+
+	type testStruct struct {
+		Timeout  time.Duration   `json:"timeout" validate:"required|duration|duration_max:1h"`
+		Interval *time.Duration  `json:"interval"`
+		Backoff  []time.Duration `json:"backoff"`
+		Retry    retryStruct     `json:"retry"`
+		Label    string          `json:"label"`
+	}
+
+	interval := 1500 * time.Millisecond
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+		output    *testStruct
+	}{
+		{
+			name:      "should decode go and iso durations",
+			inputJSON: `{"timeout": "PT30M", "interval": "1.5s", "backoff": ["1s", "P0D", 5], "retry": {"delay": "2s"}, "label": "1h"}`,
+			output: &testStruct{
+				Timeout:  30 * time.Minute,
+				Interval: &interval,
+				Backoff:  []time.Duration{time.Second, 0, 5},
+				Retry:    retryStruct{Delay: 2 * time.Second},
+				Label:    "1h",
+			},
+		},
+		{
+			name:      "should fail durations out of range",
+			inputJSON: `{"timeout": "2h", "retry": {"delay": "500ms"}}`,
+			want:      map[string][]string{"timeout": {"duration_max:1h"}, "retry.delay": {"duration_min:1s"}},
+		},
+		{
+			name:      "should fail invalid duration",
+			inputJSON: `{"timeout": "forever"}`,
+			want:      map[string][]string{"timeout": {"duration", "duration_max:1h"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+
+			output := &testStruct{}
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), output)
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+
+			if tt.output != nil {
+				if diff := cmp.Diff(tt.output, output); diff != "" {
+					t.Errorf("output mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateCollections(t *testing.T) {
+	t.Parallel()
+
+	type memberStruct struct {
+		ID   int    `json:"id" validate:"required"`
+		Role string `json:"role" validate:"oneof:owner,viewer"`
+	}
+
+	type groupStruct struct {
+		Members []memberStruct `json:"members" validate:"min_items:1|unique:id"`
+		Scores  []int          `json:"scores" validate:"sorted|[]min:0"`
+	}
+
+	type testStruct struct {
+		Tags        []string      `json:"tags" validate:"unique|max_items:3"`
+		Permissions []string      `json:"permissions" validate:"subset_of:read,write|contains_value:read"`
+		Groups      []groupStruct `json:"groups"`
+		Labels      []string      `json:"labels" validate:"[]alpha|unique|max_items:1"`
+	}
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+	}{
+		{
+			name:      "should pass valid collections",
+			inputJSON: `{"tags": ["a", "b"], "permissions": ["read"], "groups": [{"members": [{"id": 1, "role": "owner"}, {"id": 2}], "scores": [1, 2, 2]}]}`,
+		},
+		{
+			name:      "should fail top level collections",
+			inputJSON: `{"tags": ["a", "b", "a", "c"], "permissions": ["write", "admin"]}`,
+			want: map[string][]string{
+				"tags":        {"unique", "max_items:3"},
+				"permissions": {"subset_of:read,write", "contains_value:read"},
+			},
+		},
+		{
+			name:      "should fail element and collection rules of one tag",
+			inputJSON: `{"labels": ["a", "1", "a"]}`,
+			want: map[string][]string{
+				"labels":   {"unique", "max_items:1"},
+				"labels.1": {"alpha"},
+			},
+		},
+		{
+			name:      "should fail collections of every element",
+			inputJSON: `{"groups": [{"members": [], "scores": [2, 1]}, {"members": [{"id": 1}, {"id": 1, "role": "admin"}, {}], "scores": [-1]}]}`,
+			want: map[string][]string{
+				"groups.0.members":        {"min_items:1"},
+				"groups.0.scores":         {"sorted"},
+				"groups.1.members":        {"unique:id"},
+				"groups.1.members.1.role": {"oneof:owner,viewer"},
+				"groups.1.members.2.id":   {"required"},
+				"groups.1.scores.0":       {"min:0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), &testStruct{})
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateCollectionMessages(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Tags []string `json:"tags" validate:"min_items:2|unique"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	validationErrors, err := validator.Validate([]byte(`{"tags": []}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	expectedRules := []validation.RuleFailure{
+		{Name: "min_items", Args: []string{"2"}, Message: "must contain at least 2 items", Code: "min_items", Severity: validation.SeverityError},
+	}
+
+	if diff := cmp.Diff(expectedRules, validationErrors.Fields()[0].Rules); diff != "" {
+		t.Errorf("Rules mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidrator_ValidateMaps(t *testing.T) {
+	t.Parallel()
+
+	type addressStruct struct {
+		_       struct{} `validate:"strict"`
+		City    string   `json:"city" validate:"required"`
+		ZipCode string   `json:"zip_code" validate:"len:5"`
+	}
+
+	type testStruct struct {
+		Addresses map[string]addressStruct `json:"addresses" validate:"keys:uuid"`
+		Labels    map[string]string        `json:"labels" validate:"keys:max:8|[]max:3"`
+		Sites     map[string]addressStruct `json:"sites"`
+		Tags      map[string]string        `json:"tags" validate:"keys:alphaunicode"`
+	}
+
+	const firstID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+		output    *testStruct
+	}{
+		{
+			name:      "should keep map keys as they are",
+			inputJSON: `{"addresses": {"` + firstID + `": {"city": "Berlin", "zip_code": "10115"}}, "labels": {"team_id": "api"}}`,
+			output: &testStruct{
+				Addresses: map[string]addressStruct{firstID: {City: "Berlin", ZipCode: "10115"}},
+				Labels:    map[string]string{"team_id": "api"},
+			},
+		},
+		{
+			name:      "should apply element rules to every value",
+			inputJSON: `{"addresses": {"` + firstID + `": {"zip_code": "1", "zip": "2"}}, "labels": {"team_id": "backend"}}`,
+			want: map[string][]string{
+				"addresses." + firstID + ".city":    {"required"},
+				"addresses." + firstID + ".zipCode": {"len:5"},
+				"addresses." + firstID + ".zip":     {"unknown_field"},
+				"labels.team_id":                    {"max:3"},
+			},
+		},
+		{
+			name:      "should apply keys rules to every key",
+			inputJSON: `{"addresses": {"home": {"city": "Berlin"}}, "labels": {"environment": "dev"}}`,
+			want: map[string][]string{
+				"addresses.home":     {"keys:uuid"},
+				"labels.environment": {"keys:max:8"},
+			},
+		},
+		{
+			name:      "should apply rules to keys containing dots",
+			inputJSON: `{"sites": {"example.com": {}}, "tags": {"a.b": "x", "ab": "y"}}`,
+			want: map[string][]string{
+				"sites.example~1com.city": {"required"},
+				"tags.a~1b":               {"keys:alphaunicode"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := validrator.NewValidrator()
+
+			output := &testStruct{}
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), output)
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+
+			if tt.output != nil {
+				if diff := cmp.Diff(tt.output, output, cmp.AllowUnexported(addressStruct{})); diff != "" {
+					t.Errorf("output mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestValidrator_ValidateMapKeyAndValue(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Labels map[string]string `json:"labels" validate:"keys:max:8|[]max:3"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	// Key and value of the same entry fail, so both are reported by single fail
+	validationErrors, err := validator.Validate([]byte(`{"labels": {"environment": "backend"}}`), &testStruct{})
+	if err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	if validationErrors == nil {
+		t.Fatal("Validation errors are missing, but wanted")
+	}
+
+	fails := validationErrors.Fields()
+	if len(fails) != 1 {
+		t.Fatalf("Fields() = %d fails, want 1", len(fails))
+	}
+
+	if fails[0].Value != "backend" {
+		t.Errorf("Value = %v, want value of entry", fails[0].Value)
+	}
+
+	expected := map[string][]string{"labels.environment": {"keys:max:8", "max:3"}}
+	if diff := cmp.Diff(expected, validationErrors.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+
+	//nolint:staticcheck // Deprecated map is filled until removal
+	if rules := validationErrors.Failed["labels.environment"].Rules; len(rules) != 2 {
+		t.Errorf("Failed has %d rules, want 2", len(rules))
+	}
+
+	actualJSON, err := json.Marshal(validationErrors)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+
+	if count := strings.Count(string(actualJSON), `"labels.environment"`); count != 1 {
+		t.Errorf("json.Marshal() wrote field %d times, want once: %s", count, actualJSON)
+	}
+}
+
+func TestValidrator_ValidateRegex(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Status string `json:"status" validate:"regex:'^(draft|published)$'"`
+		Price  string `json:"price" validate:"regex:'^[0-9]{1,3}(,[0-9]{3})*$'|not_regex:'^0'"`
+		Note   string `json:"note" validate:"not_regex:'it''s'"`
+		SKU    string `json:"sku" validate:"pattern:sku"`
+		Answer string `json:"answer" validate:"oneof:don't,do"`
+		Mark   string `json:"mark" validate:"contains:'"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	err := validator.RegisterPattern("sku", `^[A-Z]{3}-\d+$`)
+	if err != nil {
+		t.Fatalf("RegisterPattern() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		inputJSON string
+		want      map[string][]string
+	}{
+		{
+			name:      "should pass matching values",
+			inputJSON: `{"status": "draft", "price": "1,250", "note": "fine", "sku": "ABC-12", "answer": "don't", "mark": "it's"}`,
+		},
+		{
+			name:      "should fail values and quote arguments with separators",
+			inputJSON: `{"status": "archived", "price": "0,250", "note": "it's", "sku": "abc-12", "answer": "dont", "mark": "its"}`,
+			want: map[string][]string{
+				"status": {"regex:'^(draft|published)$'"},
+				"price":  {"not_regex:^0"},
+				"note":   {"not_regex:it's"},
+				"sku":    {"pattern:sku"},
+				"answer": {"oneof:don't,do"},
+				"mark":   {"contains:'"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validationErrors, err := validator.Validate([]byte(tt.inputJSON), &testStruct{})
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+
+			var got map[string][]string
+			if validationErrors != nil {
+				got = validationErrors.ToMap()
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidrator_RegisterPattern(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Code string `json:"code" validate:"pattern:code"`
+	}
+
+	validator := validrator.NewValidrator()
+
+	err := validator.RegisterPattern("code", "(a")
+	if !errors.Is(err, validrator.ErrInvalidPattern) {
+		t.Errorf("RegisterPattern() error = %v, want %v", err, validrator.ErrInvalidPattern)
+	}
+
+	_, err = validator.Validate([]byte(`{"code": "a"}`), &testStruct{})
+	if !errors.Is(err, validrator.ErrInvalidRuleArgs) {
+		t.Errorf("Validate() error = %v, want %v", err, validrator.ErrInvalidRuleArgs)
+	}
+}